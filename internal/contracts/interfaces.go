@@ -2,6 +2,7 @@ package contracts
 
 import (
 	"context"
+	"log/slog"
 )
 
 // Request — структура запроса
@@ -29,3 +30,12 @@ type Logger interface {
 	// Infof логирует информационные сообщения с форматированием.
 	Infof(ctx context.Context, format string, args ...any)
 }
+
+// StructuredLogger — Logger, который дополнительно принимает структурированные атрибуты slog.
+type StructuredLogger interface {
+	Logger
+	// DebugAttrs логирует отладочное сообщение с атрибутами.
+	DebugAttrs(ctx context.Context, msg string, attrs ...slog.Attr)
+	// InfoAttrs логирует информационное сообщение с атрибутами.
+	InfoAttrs(ctx context.Context, msg string, attrs ...slog.Attr)
+}
@@ -1,14 +1,27 @@
 package http
 
 import (
+	"cmp"
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
 	"slices"
+	"sync/atomic"
+	"time"
 
 	"github.com/nepriyatelev/outline-client-go/internal/contracts"
 	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttpproxy"
+	"golang.org/x/net/http/httpproxy"
 )
 
-const defaultUserAgentName = "outline-go-client/1.0" // User-Agent header
+// DefaultUserAgent is the User-Agent header sent unless [WithUserAgent] sets another one.
+const DefaultUserAgent = "outline-go-client/1.0"
 
 // Client is a fasthttp-based HTTP client that implements the contracts.Doer interface.
 //
@@ -25,23 +38,174 @@ const defaultUserAgentName = "outline-go-client/1.0" // User-Agent header
 // consider implementing streaming or chunked processing.
 type Client struct {
 	client *fasthttp.Client
+
+	// timeout bounds requests whose context has no deadline; zero disables it.
+	timeout time.Duration
+
+	// pinnedCertSHA256 is the SHA-256 fingerprint the server leaf certificate must match, if set.
+	pinnedCertSHA256 *[sha256.Size]byte
+
+	// insecureSkipVerify disables the verification of the server certificate.
+	insecureSkipVerify bool
+
+	// localAddr is the local address outgoing connections are bound to, if set.
+	localAddr *net.TCPAddr
+
+	// proxyURL is the HTTP CONNECT or SOCKS5 proxy outgoing connections are tunneled through, if set.
+	proxyURL *url.URL
+
+	// peerCertNotAfter is the expiry of the last verified server leaf certificate
+	// in Unix nanoseconds, or zero if no TLS handshake has completed yet.
+	peerCertNotAfter atomic.Int64
 }
 
-func NewClient() *Client {
+func NewClient(opts ...Option) *Client {
 	fc := &fasthttp.Client{
-		Name: defaultUserAgentName,
+		Name: DefaultUserAgent,
 	}
 
-	return &Client{
+	c := &Client{
 		client: fc,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.insecureSkipVerify {
+		if c.client.TLSConfig == nil {
+			c.client.TLSConfig = &tls.Config{}
+		}
+		c.client.TLSConfig.InsecureSkipVerify = true
+	}
+	c.configureDialer()
+	c.pinCertificate()
+	c.capturePeerCertificates()
+
+	return c
+}
+
+// LastPeerCertExpiry returns the NotAfter time of the leaf certificate presented
+// by the server in the most recent TLS handshake.
+// The boolean is false if no TLS handshake has completed yet.
+func (c *Client) LastPeerCertExpiry() (time.Time, bool) {
+	notAfter := c.peerCertNotAfter.Load()
+	if notAfter == 0 {
+		return time.Time{}, false
+	}
+	return time.Unix(0, notAfter), true
+}
+
+// Close closes the idle keep-alive connections of the client.
+// Requests sent afterwards open new connections.
+func (c *Client) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
+// configureDialer makes the client dial from the local address set by [WithLocalAddr]
+// and through the proxy set by [WithProxy], if any.
+func (c *Client) configureDialer() {
+	if c.proxyURL != nil {
+		c.dialThroughProxy()
+		return
+	}
+	if c.localAddr == nil {
+		return
+	}
+	dialer := &fasthttp.TCPDialer{LocalAddr: c.localAddr}
+	c.client.DialTimeout = func(addr string, timeout time.Duration) (net.Conn, error) {
+		// fasthttp passes no timeout for requests without a deadline.
+		if timeout <= 0 {
+			return dialer.DialDualStack(addr)
+		}
+		return dialer.DialDualStackTimeout(addr, timeout)
+	}
+}
+
+// dialThroughProxy tunnels the connections through the proxy set by [WithProxy]:
+// an HTTP proxy is asked to CONNECT, a socks5 or socks5h one is spoken to in SOCKS5.
+// Credentials in the proxy URL are sent to the proxy.
+func (c *Client) dialThroughProxy() {
+	proxy := c.proxyURL.String()
+	dialer := &fasthttpproxy.Dialer{
+		TCPDialer: fasthttp.TCPDialer{LocalAddr: c.localAddr},
+		// The same proxy for every target and no exclusions, loopback included.
+		Config:         httpproxy.Config{HTTPProxy: proxy, HTTPSProxy: proxy},
+		DialDualStack:  true,
+		Timeout:        c.timeout,
+		ConnectTimeout: c.timeout,
+	}
+	dial, err := dialer.GetDialFunc(false)
+	if err != nil {
+		// Never fall back to a direct connection the caller asked to avoid.
+		err = fmt.Errorf("proxy %s: %w", c.proxyURL.Redacted(), err)
+		dial = func(string) (net.Conn, error) {
+			return nil, err
+		}
+	}
+	c.client.Dial = dial
+}
+
+// pinCertificate replaces the verification of the server certificate chain with a comparison
+// of the leaf certificate fingerprint against the one set by [WithCertSHA256], if any.
+func (c *Client) pinCertificate() {
+	if c.pinnedCertSHA256 == nil {
+		return
+	}
+	if c.client.TLSConfig == nil {
+		c.client.TLSConfig = &tls.Config{}
+	}
+
+	// Outline servers use self-signed certificates: the chain cannot be verified,
+	// the fingerprint is checked in VerifyConnection instead.
+	pinned := *c.pinnedCertSHA256
+	c.client.TLSConfig.InsecureSkipVerify = true
+	c.client.TLSConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return errors.New("server presented no certificate")
+		}
+		sum := sha256.Sum256(cs.PeerCertificates[0].Raw)
+		if subtle.ConstantTimeCompare(sum[:], pinned[:]) != 1 {
+			return fmt.Errorf("server certificate fingerprint %X does not match the pinned %X", sum, pinned)
+		}
+		return nil
+	}
+}
+
+// capturePeerCertificates hooks into the TLS handshake to record the expiry
+// of the server leaf certificate, keeping any VerifyConnection callback already configured.
+func (c *Client) capturePeerCertificates() {
+	if c.client.TLSConfig == nil {
+		c.client.TLSConfig = &tls.Config{}
+	}
+
+	next := c.client.TLSConfig.VerifyConnection
+	c.client.TLSConfig.VerifyConnection = func(cs tls.ConnectionState) error {
+		if next != nil {
+			if err := next(cs); err != nil {
+				return err
+			}
+		}
+		if len(cs.PeerCertificates) > 0 {
+			c.peerCertNotAfter.Store(cs.PeerCertificates[0].NotAfter.UnixNano())
+		}
+		return nil
+	}
 }
 
+// Do sends the request and returns the response.
+//
+// A context with a deadline bounds the exchange through fasthttp's DoDeadline,
+// so the request stops at the deadline and no goroutine outlives it; the context error is then returned.
+// Without a context deadline the timeout set by [WithTimeout] is used instead,
+// and its expiry is reported as [fasthttp.ErrTimeout].
+// If the context is cancelled earlier, Do returns the context error immediately,
+// while the exchange finishes in the background and releases its pooled objects itself.
 func (c *Client) Do(ctx context.Context, req *contracts.Request) (*contracts.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	fastReq := fasthttp.AcquireRequest()
-	fastResp := fasthttp.AcquireResponse()
-	defer fasthttp.ReleaseRequest(fastReq)
-	defer fasthttp.ReleaseResponse(fastResp)
 
 	// Устанавливаем URI и метод
 	fastReq.SetRequestURI(req.URL)
@@ -57,22 +221,59 @@ func (c *Client) Do(ctx context.Context, req *contracts.Request) (*contracts.Res
 		fastReq.SetBody(req.Body)
 	}
 
-	// Запускаем фактический HTTP-запрос в отдельной горутине
-	errCh := make(chan error, 1)
+	// Срок из контекста имеет приоритет над таймаутом клиента
+	deadline, ctxDeadline := ctx.Deadline()
+	if !ctxDeadline && c.timeout > 0 {
+		deadline = time.Now().Add(c.timeout)
+	}
+
+	// Контекст без отмены: выполняем запрос синхронно
+	if ctx.Done() == nil {
+		return c.exchange(fastReq, deadline)
+	}
+
+	// Запрос выполняется в отдельной горутине, которая владеет объектами из пула
+	// и сама освобождает их, поэтому отмена контекста не приводит к гонке.
+	type result struct {
+		resp *contracts.Response
+		err  error
+	}
+	resultCh := make(chan result, 1)
 	go func() {
-		errCh <- c.client.Do(fastReq, fastResp)
+		resp, err := c.exchange(fastReq, deadline)
+		resultCh <- result{resp: resp, err: err}
 	}()
 
 	// Ждём либо завершения запроса, либо отмены контекста
 	select {
-	case err := <-errCh:
-		if err != nil {
-			return nil, err
+	case res := <-resultCh:
+		if res.err != nil && ctxDeadline && errors.Is(res.err, fasthttp.ErrTimeout) {
+			// fasthttp может сообщить о таймауте раньше, чем контекст отметит истечение срока
+			return nil, cmp.Or(ctx.Err(), context.DeadlineExceeded)
 		}
+		return res.resp, res.err
 	case <-ctx.Done():
 		// При отмене контекста возвращаем её ошибку
 		return nil, ctx.Err()
 	}
+}
+
+// exchange sends fastReq, bounded by deadline unless it is zero, and converts the response.
+// It takes ownership of fastReq and releases it before returning.
+func (c *Client) exchange(fastReq *fasthttp.Request, deadline time.Time) (*contracts.Response, error) {
+	fastResp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(fastReq)
+	defer fasthttp.ReleaseResponse(fastResp)
+
+	var err error
+	if deadline.IsZero() {
+		err = c.client.Do(fastReq, fastResp)
+	} else {
+		err = c.client.DoDeadline(fastReq, fastResp, deadline)
+	}
+	if err != nil {
+		return nil, err
+	}
 
 	// Преобразуем fasthttp.Response в наш Response
 	headers := make(map[string]string, fastResp.Header.Len())
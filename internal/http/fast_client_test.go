@@ -0,0 +1,484 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestClient_LastPeerCertExpiry(t *testing.T) {
+	// Arrange
+	notAfter := time.Now().Add(48 * time.Hour).Truncate(time.Second)
+	cert := testutil.NewSelfSignedCert(t, notAfter)
+	srv := testutil.NewTLSServer(t, cert, nil)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert.Leaf)
+	client := NewClient(WithTLSConfig(&tls.Config{RootCAs: roots}))
+
+	// Act
+	_, okBefore := client.LastPeerCertExpiry()
+	resp, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+	expiry, okAfter := client.LastPeerCertExpiry()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.False(t, okBefore)
+	assert.True(t, okAfter)
+	assert.True(t, notAfter.Equal(expiry), "expiry = %v; want %v", expiry, notAfter)
+}
+
+func TestClient_LastPeerCertExpiry_UntrustedCertificate(t *testing.T) {
+	// Arrange
+	cert := testutil.NewSelfSignedCert(t, time.Now().Add(time.Hour))
+	srv := testutil.NewTLSServer(t, cert, nil)
+	client := NewClient()
+
+	// Act
+	_, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+	_, ok := client.LastPeerCertExpiry()
+
+	// Assert
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
+
+// newSlowServer starts an HTTP server that answers after the given delay.
+func newSlowServer(t *testing.T, delay time.Duration) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(func() {
+		srv.CloseClientConnections()
+		srv.Close()
+	})
+	return srv
+}
+
+func TestClient_Do_Deadline(t *testing.T) {
+	tests := []struct {
+		name        string
+		delay       time.Duration
+		timeout     time.Duration
+		expectedErr error
+	}{
+		{
+			name:    "response before deadline",
+			delay:   0,
+			timeout: 5 * time.Second,
+		},
+		{
+			name:        "deadline exceeded",
+			delay:       5 * time.Second,
+			timeout:     50 * time.Millisecond,
+			expectedErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			srv := newSlowServer(t, tt.delay)
+			client := NewClient()
+			ctx, cancel := context.WithTimeout(context.Background(), tt.timeout)
+			defer cancel()
+
+			// Act
+			start := time.Now()
+			resp, err := client.Do(ctx, &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+			elapsed := time.Since(start)
+
+			// Assert
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, resp)
+				assert.Less(t, elapsed, time.Second)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+	}
+}
+
+func TestClient_Do_Cancel(t *testing.T) {
+	// Arrange
+	srv := newSlowServer(t, 5*time.Second)
+	client := NewClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	// Act
+	resp, err := client.Do(ctx, &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, resp)
+}
+
+func TestClient_Do_ContextDone(t *testing.T) {
+	// Arrange
+	client := NewClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	resp, err := client.Do(ctx, &contracts.Request{Method: http.MethodGet, URL: "http://127.0.0.1:1"})
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Nil(t, resp)
+}
+
+func TestClient_Do_Timeout(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         func() (context.Context, context.CancelFunc)
+		expectedErr error
+	}{
+		{
+			name: "background context times out",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.Background(), func() {}
+			},
+			expectedErr: fasthttp.ErrTimeout,
+		},
+		{
+			name: "cancellable context times out",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			expectedErr: fasthttp.ErrTimeout,
+		},
+		{
+			name: "context deadline overrides timeout",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 20*time.Millisecond)
+			},
+			expectedErr: context.DeadlineExceeded,
+		},
+		{
+			name: "cancellation is not a timeout",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(20*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			expectedErr: context.Canceled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			srv := newSlowServer(t, 5*time.Second)
+			client := NewClient(WithTimeout(100 * time.Millisecond))
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			// Act
+			start := time.Now()
+			resp, err := client.Do(ctx, &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+			elapsed := time.Since(start)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedErr)
+			if tt.expectedErr == fasthttp.ErrTimeout {
+				assert.NotErrorIs(t, err, context.DeadlineExceeded)
+				assert.NotErrorIs(t, err, context.Canceled)
+			}
+			assert.Nil(t, resp)
+			assert.Less(t, elapsed, time.Second)
+		})
+	}
+}
+
+func TestClient_Do_TimeoutNotReached(t *testing.T) {
+	// Arrange
+	srv := newSlowServer(t, 0)
+	client := NewClient(WithTimeout(5 * time.Second))
+
+	// Act
+	resp, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestClient_Do_CancelThenReuse(t *testing.T) {
+	// Arrange
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-time.After(200 * time.Millisecond):
+			case <-r.Context().Done():
+			}
+		}
+		_, _ = w.Write([]byte(r.URL.Path))
+	}))
+	t.Cleanup(func() {
+		srv.CloseClientConnections()
+		srv.Close()
+	})
+	client := NewClient()
+
+	for range 10 {
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		// Act
+		_, cancelErr := client.Do(ctx, &contracts.Request{Method: http.MethodGet, URL: srv.URL + "/slow"})
+		resp, err := client.Do(context.Background(), &contracts.Request{
+			Method:  http.MethodPost,
+			URL:     srv.URL + "/fast",
+			Headers: map[string]string{"X-Request": "fast"},
+			Body:    []byte("payload"),
+		})
+
+		// Assert
+		require.ErrorIs(t, cancelErr, context.Canceled)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "/fast", string(resp.Body))
+	}
+}
+
+func TestClient_WithCertSHA256(t *testing.T) {
+	cert := testutil.NewSelfSignedCert(t, time.Now().Add(time.Hour))
+	other := testutil.NewSelfSignedCert(t, time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name        string
+		fingerprint [sha256.Size]byte
+		expectErr   bool
+	}{
+		{name: "matching fingerprint", fingerprint: sha256.Sum256(cert.Leaf.Raw)},
+		{name: "mismatched fingerprint", fingerprint: sha256.Sum256(other.Leaf.Raw), expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			srv := testutil.NewTLSServer(t, cert, nil)
+			client := NewClient(WithCertSHA256(tt.fingerprint))
+
+			// Act
+			resp, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+			_, certSeen := client.LastPeerCertExpiry()
+
+			// Assert
+			if tt.expectErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "does not match the pinned")
+				assert.Nil(t, resp)
+				assert.False(t, certSeen)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.True(t, certSeen)
+		})
+	}
+}
+
+func TestClient_WithInsecureSkipVerify(t *testing.T) {
+	tests := []struct {
+		name      string
+		skip      bool
+		expectErr bool
+	}{
+		{name: "verification skipped", skip: true},
+		{name: "verification kept", skip: false, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			cert := testutil.NewSelfSignedCert(t, time.Now().Add(time.Hour))
+			srv := testutil.NewTLSServer(t, cert, nil)
+			client := NewClient(WithInsecureSkipVerify(tt.skip))
+
+			// Act
+			resp, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+
+			// Assert
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+	}
+}
+
+func TestClient_WithLocalAddr(t *testing.T) {
+	// Arrange
+	localIP := net.ParseIP("127.0.0.2")
+	if l, err := net.Listen("tcp", "127.0.0.2:0"); err != nil {
+		t.Skipf("loopback address %s is not usable: %v", localIP, err)
+	} else {
+		_ = l.Close()
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, _ := net.SplitHostPort(r.RemoteAddr)
+		_, _ = w.Write([]byte(host))
+	}))
+	t.Cleanup(srv.Close)
+	client := NewClient(WithLocalAddr(&net.TCPAddr{IP: localIP}))
+
+	// Act
+	resp, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, localIP.String(), string(resp.Body))
+}
+
+func TestClient_WithProxy_SOCKS5(t *testing.T) {
+	tests := []struct {
+		name      string
+		proxyUser *url.Userinfo
+		expectErr bool
+	}{
+		{name: "valid credentials", proxyUser: url.UserPassword("admin", "s3cret")},
+		{name: "invalid credentials", proxyUser: url.UserPassword("admin", "wrong"), expectErr: true},
+		{name: "missing credentials", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("proxied"))
+			}))
+			t.Cleanup(srv.Close)
+			proxy := testutil.NewSOCKS5Proxy(t, "admin", "s3cret")
+			client := NewClient(WithProxy(&url.URL{Scheme: "socks5", User: tt.proxyUser, Host: proxy.Addr}))
+
+			// Act
+			resp, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+
+			// Assert
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Empty(t, proxy.Targets())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "proxied", string(resp.Body))
+			assert.Equal(t, []string{srv.Listener.Addr().String()}, proxy.Targets())
+		})
+	}
+}
+
+func TestClient_WithProxy_HTTPConnect(t *testing.T) {
+	// Arrange
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("proxied"))
+	}))
+	t.Cleanup(srv.Close)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	connects := make(chan *http.Request, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		req, err := http.ReadRequest(bufio.NewReader(conn))
+		if err != nil {
+			return
+		}
+		connects <- req
+		upstream, err := net.Dial("tcp", req.Host)
+		if err != nil {
+			return
+		}
+		defer upstream.Close()
+		_, _ = conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		go func() { _, _ = io.Copy(upstream, conn) }()
+		_, _ = io.Copy(conn, upstream)
+	}()
+	client := NewClient(WithProxy(&url.URL{Scheme: "http", User: url.UserPassword("admin", "s3cret"), Host: l.Addr().String()}))
+
+	// Act
+	resp, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "proxied", string(resp.Body))
+	connect := <-connects
+	assert.Equal(t, http.MethodConnect, connect.Method)
+	assert.Equal(t, srv.Listener.Addr().String(), connect.Host)
+	assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("admin:s3cret")),
+		connect.Header.Get("Proxy-Authorization"))
+}
+
+func TestClient_WithProxy_UnsupportedScheme(t *testing.T) {
+	// Arrange
+	client := NewClient(WithProxy(&url.URL{Scheme: "ftp", Host: "127.0.0.1:21"}))
+
+	// Act
+	resp, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: "http://127.0.0.1:1/"})
+
+	// Assert
+	assert.Nil(t, resp)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "proxy ftp://127.0.0.1:21")
+}
+
+func TestClient_WithUserAgent(t *testing.T) {
+	tests := []struct {
+		name              string
+		userAgent         string
+		expectedUserAgent string
+	}{
+		{name: "custom", userAgent: "billing-sync/2.3", expectedUserAgent: "billing-sync/2.3"},
+		{name: "empty keeps default", expectedUserAgent: DefaultUserAgent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(r.UserAgent()))
+			}))
+			t.Cleanup(srv.Close)
+			client := NewClient(WithUserAgent(tt.userAgent))
+
+			// Act
+			resp, err := client.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedUserAgent, string(resp.Body))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"net"
+	"net/url"
+	"time"
+)
+
+// Option configures the fasthttp-based [Client].
+type Option func(*Client)
+
+// WithTLSConfig sets the TLS configuration used for HTTPS connections.
+// The configuration is cloned, so later changes by the caller have no effect.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *Client) {
+		if cfg != nil {
+			c.client.TLSConfig = cfg.Clone()
+		}
+	}
+}
+
+// WithCertSHA256 pins the server certificate: a connection is accepted only if the SHA-256 fingerprint
+// of the leaf certificate equals fingerprint, and the certificate chain is not verified otherwise.
+// This is how Outline clients trust the self-signed certificate of a management server,
+// using the certSha256 value of its access config. It replaces any VerifyConnection callback
+// of the configuration set by [WithTLSConfig].
+func WithCertSHA256(fingerprint [sha256.Size]byte) Option {
+	return func(c *Client) {
+		c.pinnedCertSHA256 = &fingerprint
+	}
+}
+
+// WithInsecureSkipVerify disables the verification of the server certificate when skip is true,
+// accepting any certificate, including one presented by an attacker. It is meant for development
+// servers only; prefer [WithCertSHA256] to trust a self-signed certificate.
+func WithInsecureSkipVerify(skip bool) Option {
+	return func(c *Client) {
+		c.insecureSkipVerify = skip
+	}
+}
+
+// WithTimeout bounds every request by d, so a call made with a context without a deadline,
+// such as [context.Background], cannot block indefinitely. Such a request fails with
+// [fasthttp.ErrTimeout], unlike a cancelled or expired context, which reports the context error.
+// A context deadline replaces d as the deadline of the call, but reading the response and
+// writing the request stay bounded by d each. A zero or negative d disables the timeout.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		if d <= 0 {
+			return
+		}
+		c.timeout = d
+		c.client.ReadTimeout = d
+		c.client.WriteTimeout = d
+	}
+}
+
+// WithLocalAddr binds the outgoing connections to the local address addr, so that on a multi-homed host
+// requests leave through the interface that owns it. A zero port lets the system choose the source port.
+// A nil addr keeps the default dialer.
+func WithLocalAddr(addr *net.TCPAddr) Option {
+	return func(c *Client) {
+		c.localAddr = addr
+	}
+}
+
+// WithProxy tunnels the outgoing connections through the proxy at proxyURL: an "http" proxy through
+// HTTP CONNECT, a "socks5" or "socks5h" one through SOCKS5. User information in the URL is sent as
+// the proxy credentials. Connections to the proxy itself are bound by [WithLocalAddr], if set.
+// A nil proxyURL keeps direct connections.
+func WithProxy(proxyURL *url.URL) Option {
+	return func(c *Client) {
+		c.proxyURL = proxyURL
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with requests that do not set one themselves.
+// An empty name keeps [DefaultUserAgent].
+func WithUserAgent(name string) Option {
+	return func(c *Client) {
+		if name == "" {
+			return
+		}
+		c.client.Name = name
+	}
+}
@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// SlogLogger adapts a [slog.Logger] to contracts.StructuredLogger.
+// Formatted messages become the record message; attributes are passed through unchanged.
+type SlogLogger struct {
+	logger *slog.Logger
+}
+
+// NewSlogLogger returns a logger writing to l.
+func NewSlogLogger(l *slog.Logger) *SlogLogger {
+	return &SlogLogger{logger: l}
+}
+
+func (l *SlogLogger) Debugf(ctx context.Context, format string, args ...any) {
+	l.logf(ctx, slog.LevelDebug, format, args...)
+}
+
+func (l *SlogLogger) Infof(ctx context.Context, format string, args ...any) {
+	l.logf(ctx, slog.LevelInfo, format, args...)
+}
+
+func (l *SlogLogger) DebugAttrs(ctx context.Context, msg string, attrs ...slog.Attr) {
+	l.logger.LogAttrs(ctx, slog.LevelDebug, msg, attrs...)
+}
+
+func (l *SlogLogger) InfoAttrs(ctx context.Context, msg string, attrs ...slog.Attr) {
+	l.logger.LogAttrs(ctx, slog.LevelInfo, msg, attrs...)
+}
+
+// logf formats the message only if the level is enabled.
+func (l *SlogLogger) logf(ctx context.Context, level slog.Level, format string, args ...any) {
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+	l.logger.Log(ctx, level, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,68 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// decodeRecords parses the records written by a slog JSON handler.
+func decodeRecords(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var records []map[string]any
+	for line := range strings.Lines(buf.String()) {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestSlogLogger(t *testing.T) {
+	tests := []struct {
+		name           string
+		level          slog.Level
+		expectedLevels []string
+	}{
+		{name: "debug enabled", level: slog.LevelDebug, expectedLevels: []string{"DEBUG", "INFO", "DEBUG", "INFO"}},
+		{name: "info only", level: slog.LevelInfo, expectedLevels: []string{"INFO", "INFO"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var buf bytes.Buffer
+			l := NewSlogLogger(slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: tt.level})))
+			ctx := context.Background()
+
+			// Act
+			l.Debugf(ctx, "debug %d", 1)
+			l.Infof(ctx, "info %s", "two")
+			l.DebugAttrs(ctx, "debug attrs", slog.String("key", "value"))
+			l.InfoAttrs(ctx, "info attrs", slog.Int("n", 4))
+
+			// Assert
+			records := decodeRecords(t, &buf)
+			levels := make([]string, 0, len(records))
+			for _, record := range records {
+				levels = append(levels, record["level"].(string))
+			}
+			assert.Equal(t, tt.expectedLevels, levels)
+			last := records[len(records)-1]
+			assert.Equal(t, "info attrs", last["msg"])
+			assert.InDelta(t, 4, last["n"], 0)
+			if tt.level == slog.LevelDebug {
+				assert.Equal(t, "debug 1", records[0]["msg"])
+				assert.Equal(t, "value", records[2]["key"])
+			}
+			assert.Contains(t, buf.String(), `"msg":"info two"`)
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// SOCKS5Proxy is a minimal SOCKS5 proxy supporting the CONNECT command,
+// with no authentication or with the username/password method.
+type SOCKS5Proxy struct {
+	// Addr is the host:port the proxy listens on.
+	Addr string
+
+	username string
+	password string
+	listener net.Listener
+
+	mu      sync.Mutex
+	targets []string
+}
+
+// NewSOCKS5Proxy starts a SOCKS5 proxy on a loopback port. If username is not empty,
+// clients must authenticate with username and password. The proxy is closed on test cleanup.
+func NewSOCKS5Proxy(t testing.TB, username, password string) *SOCKS5Proxy {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	p := &SOCKS5Proxy{Addr: l.Addr().String(), username: username, password: password, listener: l}
+	t.Cleanup(func() { _ = l.Close() })
+
+	go p.serve()
+
+	return p
+}
+
+// Targets returns the host:port addresses clients asked the proxy to connect to, in order.
+func (p *SOCKS5Proxy) Targets() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.targets...)
+}
+
+func (p *SOCKS5Proxy) serve() {
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+		go p.handle(conn)
+	}
+}
+
+// handle negotiates the SOCKS5 session of conn and relays it to the requested target.
+func (p *SOCKS5Proxy) handle(conn net.Conn) {
+	defer conn.Close()
+
+	target, err := p.handshake(conn)
+	if err != nil {
+		return
+	}
+	upstream, err := net.Dial("tcp", target)
+	if err != nil {
+		_, _ = conn.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0}) // connection refused
+		return
+	}
+	defer upstream.Close()
+	p.mu.Lock()
+	p.targets = append(p.targets, target)
+	p.mu.Unlock()
+	if _, err := conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}); err != nil {
+		return
+	}
+
+	done := make(chan struct{}, 2)
+	relay := func(dst, src net.Conn) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go relay(upstream, conn)
+	go relay(conn, upstream)
+	<-done
+}
+
+// handshake runs the method selection, authentication and request phases of RFC 1928
+// and returns the requested target.
+func (p *SOCKS5Proxy) handshake(conn net.Conn) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", err
+	}
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+
+	method := byte(0) // no authentication
+	if p.username != "" {
+		method = 2 // username/password
+	}
+	if header[0] != 5 || !bytes.Contains(methods, []byte{method}) {
+		_, _ = conn.Write([]byte{5, 0xff})
+		return "", errors.New("no acceptable authentication method")
+	}
+	if _, err := conn.Write([]byte{5, method}); err != nil {
+		return "", err
+	}
+	if method == 2 {
+		if err := p.authenticate(conn); err != nil {
+			return "", err
+		}
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", err
+	}
+	if request[1] != 1 {
+		return "", errors.New("only CONNECT is supported")
+	}
+	var host string
+	switch request[3] {
+	case 1, 4: // IPv4, IPv6
+		ip := make(net.IP, map[byte]int{1: net.IPv4len, 4: net.IPv6len}[request[3]])
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = ip.String()
+	case 3: // domain name
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", err
+		}
+		name := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, name); err != nil {
+			return "", err
+		}
+		host = string(name)
+	default:
+		return "", errors.New("unknown address type")
+	}
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// authenticate checks the username and password sent by the client as described in RFC 1929.
+func (p *SOCKS5Proxy) authenticate(conn net.Conn) error {
+	readField := func() (string, error) {
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", err
+		}
+		field := make([]byte, length[0])
+		_, err := io.ReadFull(conn, field)
+		return string(field), err
+	}
+
+	version := make([]byte, 1)
+	if _, err := io.ReadFull(conn, version); err != nil {
+		return err
+	}
+	username, err := readField()
+	if err != nil {
+		return err
+	}
+	password, err := readField()
+	if err != nil {
+		return err
+	}
+	if username != p.username || password != p.password {
+		_, _ = conn.Write([]byte{1, 1})
+		return errors.New("invalid credentials")
+	}
+	_, err = conn.Write([]byte{1, 0})
+	return err
+}
@@ -0,0 +1,102 @@
+package outline
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// ValidateAccessConfig checks a pasted management API access config before a client is built from it:
+// the blob must be a JSON object whose apiUrl is an https URL with a host and a non-empty secret path,
+// and whose certSha256, if present, is a SHA-256 fingerprint of 64 hexadecimal characters.
+//
+// It returns [*ArgumentError] wrapping [InvalidArgumentError] naming the offending field,
+// or "jsonBlob" if the blob is not a valid JSON object.
+func ValidateAccessConfig(jsonBlob []byte) error {
+	_, err := ParseInviteJSON(jsonBlob)
+	return err
+}
+
+// ParseInviteJSON decodes and validates a management API access config, such as the invitation
+// handed out by Outline Manager, for use with [NewClientFromConfig].
+//
+// It returns the errors of [ValidateAccessConfig].
+func ParseInviteJSON(jsonBlob []byte) (types.OutlineConfig, error) {
+	var cfg types.OutlineConfig
+	if err := json.Unmarshal(jsonBlob, &cfg); err != nil {
+		return types.OutlineConfig{}, errInvalidArgument("jsonBlob", err)
+	}
+	if _, _, err := splitOutlineConfig(cfg); err != nil {
+		return types.OutlineConfig{}, err
+	}
+	return cfg, nil
+}
+
+// NewClientFromConfig creates a [Client] for the server described by cfg, e.g. as returned by
+// [ParseInviteJSON]. The secret is the last path segment of cfg.APIURL and the base URL is the rest,
+// so "https://1.2.3.4:1234/prefix/AbC123/" targets "https://1.2.3.4:1234/prefix/" with secret "AbC123".
+// If cfg.CertSHA256 is set, the server certificate is pinned with [WithCertSHA256];
+// options are applied after it.
+//
+// It returns [*ArgumentError] wrapping [InvalidArgumentError] if cfg is invalid,
+// see [ValidateAccessConfig], and otherwise the errors of [NewClient].
+func NewClientFromConfig(cfg types.OutlineConfig, options ...Option) (*Client, error) {
+	baseURL, secret, err := splitOutlineConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.CertSHA256 != "" {
+		options = append([]Option{WithCertSHA256(cfg.CertSHA256)}, options...)
+	}
+	return NewClient(baseURL, secret, options...)
+}
+
+// splitOutlineConfig validates cfg and splits its API URL into the base URL and the secret.
+func splitOutlineConfig(cfg types.OutlineConfig) (baseURL, secret string, err error) {
+	if cfg.APIURL == "" {
+		return "", "", errInvalidArgument("apiUrl", errors.New("apiUrl is missing"))
+	}
+	apiURL, err := url.Parse(cfg.APIURL)
+	if err != nil {
+		return "", "", errInvalidArgument("apiUrl", err)
+	}
+	if apiURL.Scheme != "https" {
+		return "", "", errInvalidArgument("apiUrl", fmt.Errorf("scheme %q is not https", apiURL.Scheme))
+	}
+	if apiURL.Hostname() == "" {
+		return "", "", errInvalidArgument("apiUrl", errors.New("host is missing"))
+	}
+	basePath, secret := path.Split(strings.TrimRight(apiURL.Path, "/"))
+	if secret == "" {
+		return "", "", errInvalidArgument("apiUrl", errors.New("secret path is missing"))
+	}
+
+	if cfg.CertSHA256 != "" {
+		if _, err := parseCertSHA256(cfg.CertSHA256); err != nil {
+			return "", "", errInvalidArgument("certSha256", err)
+		}
+	}
+
+	base := url.URL{Scheme: apiURL.Scheme, Host: apiURL.Host, Path: basePath}
+	return base.String(), secret, nil
+}
+
+// parseCertSHA256 decodes a certificate fingerprint given as 64 hexadecimal characters,
+// the format of certSha256 in the access config.
+func parseCertSHA256(fingerprint string) ([sha256.Size]byte, error) {
+	var sum [sha256.Size]byte
+	if len(fingerprint) != hex.EncodedLen(sha256.Size) {
+		return sum, fmt.Errorf("fingerprint has %d characters, want %d", len(fingerprint), hex.EncodedLen(sha256.Size))
+	}
+	if _, err := hex.Decode(sum[:], []byte(fingerprint)); err != nil {
+		return sum, fmt.Errorf("fingerprint is not hexadecimal: %w", err)
+	}
+	return sum, nil
+}
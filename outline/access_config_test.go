@@ -0,0 +1,185 @@
+package outline
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/testutil"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === ValidateAccessConfig Tests ===
+
+func TestValidateAccessConfig(t *testing.T) {
+	const fingerprint = "9A2F0B6C1D3E4F5A6B7C8D9E0F1A2B3C4D5E6F7A8B9C0D1E2F3A4B5C6D7E8F90"
+
+	tests := []struct {
+		name             string
+		blob             string
+		expectedArgument string
+	}{
+		{name: "valid", blob: `{"apiUrl":"https://1.2.3.4:1234/AbC123","certSha256":"` + fingerprint + `"}`},
+		{name: "valid without fingerprint", blob: `{"apiUrl":"https://vpn.example.com:1234/AbC123/"}`},
+		{name: "not json", blob: `{"apiUrl":"https://1.2.3.4:1234/Ab`, expectedArgument: "jsonBlob"},
+		{name: "missing url", blob: `{"certSha256":"` + fingerprint + `"}`, expectedArgument: "apiUrl"},
+		{name: "http url", blob: `{"apiUrl":"http://1.2.3.4:1234/AbC123"}`, expectedArgument: "apiUrl"},
+		{name: "unparsable url", blob: `{"apiUrl":"https://1.2.3.4:port/AbC123"}`, expectedArgument: "apiUrl"},
+		{name: "missing host", blob: `{"apiUrl":"https:///AbC123"}`, expectedArgument: "apiUrl"},
+		{name: "missing secret", blob: `{"apiUrl":"https://1.2.3.4:1234/"}`, expectedArgument: "apiUrl"},
+		{
+			name:             "truncated fingerprint",
+			blob:             `{"apiUrl":"https://1.2.3.4:1234/AbC123","certSha256":"` + fingerprint[:40] + `"}`,
+			expectedArgument: "certSha256",
+		},
+		{
+			name:             "non-hex fingerprint",
+			blob:             `{"apiUrl":"https://1.2.3.4:1234/AbC123","certSha256":"` + fingerprint[:63] + `Z"}`,
+			expectedArgument: "certSha256",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := ValidateAccessConfig([]byte(tt.blob))
+
+			// Assert
+			if tt.expectedArgument == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var argErr *ArgumentError
+			require.ErrorAs(t, err, &argErr)
+			assert.ErrorIs(t, err, InvalidArgumentError)
+			assert.Contains(t, err.Error(), "argument: "+tt.expectedArgument)
+		})
+	}
+}
+
+// === ParseInviteJSON Tests ===
+
+func TestParseInviteJSON(t *testing.T) {
+	// Arrange
+	blob := []byte(`{"apiUrl":"https://203.0.113.7:42163/Xk2bHc9aQpZ1vW3mNtYs4A","certSha256":"` +
+		strings.Repeat("C0FFEE", 10) + `AB12"}`)
+
+	// Act
+	cfg, err := ParseInviteJSON(blob)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, types.OutlineConfig{
+		APIURL:     "https://203.0.113.7:42163/Xk2bHc9aQpZ1vW3mNtYs4A",
+		CertSHA256: strings.Repeat("C0FFEE", 10) + "AB12",
+	}, cfg)
+}
+
+func TestParseInviteJSON_Invalid(t *testing.T) {
+	// Act
+	cfg, err := ParseInviteJSON([]byte(`{"apiUrl":"http://203.0.113.7:42163/Xk2bHc9aQpZ1vW3mNtYs4A"}`))
+
+	// Assert
+	assert.Zero(t, cfg)
+	assert.ErrorIs(t, err, InvalidArgumentError)
+}
+
+// === NewClientFromConfig Tests ===
+
+func TestNewClientFromConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		apiURL         string
+		expectedSecret string
+		expectedServer string
+	}{
+		{
+			name:           "without trailing slash",
+			apiURL:         "https://203.0.113.7:42163/Xk2bHc9aQpZ1vW3mNtYs4A",
+			expectedSecret: "Xk2bHc9aQpZ1vW3mNtYs4A",
+			expectedServer: "https://203.0.113.7:42163/Xk2bHc9aQpZ1vW3mNtYs4A/server",
+		},
+		{
+			name:           "with trailing slash",
+			apiURL:         "https://203.0.113.7:42163/Xk2bHc9aQpZ1vW3mNtYs4A/",
+			expectedSecret: "Xk2bHc9aQpZ1vW3mNtYs4A",
+			expectedServer: "https://203.0.113.7:42163/Xk2bHc9aQpZ1vW3mNtYs4A/server",
+		},
+		{
+			name:           "extra path segments",
+			apiURL:         "https://vpn.example.com/outline/api/Xk2bHc9aQpZ1vW3mNtYs4A",
+			expectedSecret: "Xk2bHc9aQpZ1vW3mNtYs4A",
+			expectedServer: "https://vpn.example.com/outline/api/Xk2bHc9aQpZ1vW3mNtYs4A/server",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClientFromConfig(types.OutlineConfig{APIURL: tt.apiURL})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSecret, client.secret)
+			assert.Equal(t, tt.expectedServer, client.getServerInfoPath.String())
+		})
+	}
+}
+
+func TestNewClientFromConfig_PinsCertificate(t *testing.T) {
+	// Arrange
+	cert := testutil.NewSelfSignedCert(t, time.Now().Add(time.Hour))
+	var requestedPath string
+	srv := testutil.NewTLSServer(t, cert, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPath = r.URL.Path
+		_, _ = w.Write([]byte(`{"name":"Invited"}`))
+	}))
+	sum := sha256.Sum256(cert.Leaf.Raw)
+	blob := fmt.Sprintf(`{"apiUrl":"%s/Xk2bHc9aQpZ1vW3mNtYs4A","certSha256":"%X"}`, srv.URL, sum)
+	cfg, err := ParseInviteJSON([]byte(blob))
+	require.NoError(t, err)
+
+	// Act
+	client, err := NewClientFromConfig(cfg)
+	require.NoError(t, err)
+	info, err := client.GetServerInfo(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Invited", info.Name)
+	assert.Equal(t, "/Xk2bHc9aQpZ1vW3mNtYs4A/server", requestedPath)
+}
+
+func TestNewClientFromConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		name             string
+		cfg              types.OutlineConfig
+		expectedArgument string
+	}{
+		{name: "missing url", cfg: types.OutlineConfig{}, expectedArgument: "apiUrl"},
+		{name: "missing secret", cfg: types.OutlineConfig{APIURL: "https://203.0.113.7:42163/"}, expectedArgument: "apiUrl"},
+		{
+			name:             "malformed fingerprint",
+			cfg:              types.OutlineConfig{APIURL: "https://203.0.113.7:42163/abc", CertSHA256: "ABCD"},
+			expectedArgument: "certSha256",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClientFromConfig(tt.cfg)
+
+			// Assert
+			assert.Nil(t, client)
+			assert.ErrorIs(t, err, InvalidArgumentError)
+			assert.Contains(t, err.Error(), "argument: "+tt.expectedArgument)
+		})
+	}
+}
@@ -3,7 +3,13 @@ package outline
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/nepriyatelev/outline-client-go/internal/contracts"
 	"github.com/nepriyatelev/outline-client-go/outline/types"
@@ -14,65 +20,261 @@ import (
 // CreateAccessKey creates a new access key on the server with the provided configuration.
 // It returns the created access key or an error if the operation fails.
 //
-// It returns [*ClientError] for unexpected HTTP status codes,
+// It returns [*ClientError] wrapping [KeyQuotaExceededError] if [WithMaxKeys] refuses the key,
+// [*ArgumentError] wrapping [InvalidEncryptionMethodError] if the method is set but supported
+// neither by this package nor by the server, the errors of [Client.GetServerInfo] if checking
+// the methods of the server fails,
+// [*ClientError] for unexpected HTTP status codes,
 // [*UnmarshalError] if JSON parsing fails,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) CreateAccessKey(ctx context.Context, createAccessKey *types.CreateAccessKey) (
 	*types.AccessKey, error,
+) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	if err := c.validateCreateMethod(ctx, createAccessKey); err != nil {
+		return nil, err
+	}
+
+	return c.withKeySlot(ctx, opCreateAccessKey, func() (*types.AccessKey, error) {
+		return c.createAccessKey(ctx, createAccessKey)
+	})
+}
+
+// createAccessKey implements [Client.CreateAccessKey] without the [WithMaxKeys] guard.
+func (c *Client) createAccessKey(ctx context.Context, createAccessKey *types.CreateAccessKey) (
+	*types.AccessKey, error,
 ) {
 	var reqBodyBytes []byte
 
 	if createAccessKey != nil {
-		reqBodyBytes, _ = json.Marshal(createAccessKey)
+		reqBodyBytes, _ = json.Marshal(c.withCreateDefaults(createAccessKey))
 	}
 
 	req := &contracts.Request{
 		Method:  http.MethodPost,
 		URL:     c.postAccessKeyPath.String(),
-		Headers: DefaultHeaders(),
+		Headers: withIdempotencyKey(ctx, DefaultHeaders()),
 		Body:    reqBodyBytes,
 	}
 
-	c.logRequest(ctx, "CreateAccessKey", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "CreateAccessKey", req)
 	if err != nil {
 		return nil, errDoCreateAccessKey(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusCreated:
-		return unmarshalJSONWithError[types.AccessKey](resp.Body)
+		key, err := unmarshalJSONWithError[types.AccessKey](resp.Body, c.unmarshalOptions()...)
+		return c.withAccessURLHost(ctx, key, err)
+	default:
+		return nil, c.finishClientError(ctx, errStatusCode(opCreateAccessKey, resp.StatusCode, resp.Body))
+	}
+}
+
+// CreateAccessKeyWithID creates a new access key with the caller-chosen ID
+// and the provided configuration. It returns the created access key or an error if the operation fails.
+//
+// It returns [*ClientError] wrapping [KeyQuotaExceededError] if [WithMaxKeys] refuses the key,
+// [*ArgumentError] wrapping [InvalidEncryptionMethodError] if the method is set but supported
+// neither by this package nor by the server, the errors of [Client.GetServerInfo] if checking
+// the methods of the server fails,
+// [*ClientError] with code 409 wrapping [KeyAlreadyExistsError]
+// if an access key with the ID already exists,
+// [*ClientError] for other unexpected HTTP status codes,
+// [*UnmarshalError] if JSON parsing fails,
+// or [*DoError] if the HTTP request fails.
+func (c *Client) CreateAccessKeyWithID(ctx context.Context, accessKeyID string,
+	createAccessKey *types.CreateAccessKey,
+) (*types.AccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	if err := c.validateCreateMethod(ctx, createAccessKey); err != nil {
+		return nil, err
+	}
+
+	return c.withKeySlot(ctx, opCreateAccessKeyWithID, func() (*types.AccessKey, error) {
+		return c.createAccessKeyWithID(ctx, accessKeyID, createAccessKey)
+	})
+}
+
+// createAccessKeyWithID implements [Client.CreateAccessKeyWithID] without the [WithMaxKeys] guard.
+func (c *Client) createAccessKeyWithID(ctx context.Context, accessKeyID string,
+	createAccessKey *types.CreateAccessKey,
+) (*types.AccessKey, error) {
+	var reqBodyBytes []byte
+
+	if createAccessKey != nil {
+		reqBodyBytes, _ = json.Marshal(c.withCreateDefaults(createAccessKey))
+	}
+
+	req := &contracts.Request{
+		Method:  http.MethodPut,
+		URL:     c.idPath(c.putAccessKeyPath, accessKeyID),
+		Headers: DefaultHeaders(),
+		Body:    reqBodyBytes,
+	}
+
+	resp, err := c.do(ctx, "CreateAccessKeyWithID", req)
+	if err != nil {
+		return nil, errDoCreateAccessKeyWithID(err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusCreated:
+		key, err := unmarshalJSONWithError[types.AccessKey](resp.Body, c.unmarshalOptions()...)
+		return c.withAccessURLHost(ctx, key, err)
+	case http.StatusConflict:
+		return nil, c.finishClientError(ctx, errKeyAlreadyExists(opCreateAccessKeyWithID, http.StatusConflict, accessKeyID))
 	default:
-		return nil, errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return nil, c.finishClientError(ctx, errStatusCode(opCreateAccessKeyWithID, resp.StatusCode, resp.Body))
+	}
+}
+
+// withCreateDefaults returns a copy of the create request with
+// the client-wide defaults applied to the fields left unset.
+func (c *Client) withCreateDefaults(createAccessKey *types.CreateAccessKey) *types.CreateAccessKey {
+	withDefaults := *createAccessKey
+	if withDefaults.Port == 0 {
+		withDefaults.Port = c.defaultPort
+	}
+	return &withDefaults
+}
+
+// validateCreateMethod rejects an encryption method the server does not support before it is sent.
+// An empty method is allowed: the server then chooses its default. A method that is not in
+// [types.ValidEncryptionMethods] is checked against [Client.SupportedEncryptionMethods],
+// so methods that only the server reports are accepted at the cost of a server information request.
+func (c *Client) validateCreateMethod(ctx context.Context, createAccessKey *types.CreateAccessKey) error {
+	if createAccessKey == nil || createAccessKey.Method == "" || types.IsValidEncryptionMethod(createAccessKey.Method) {
+		return nil
+	}
+
+	supported, err := c.SupportedEncryptionMethods(ctx)
+	if err != nil {
+		return err
 	}
+	if slices.Contains(supported, createAccessKey.Method) {
+		return nil
+	}
+	return errInvalidEncryptionMethod(createAccessKey.Method)
 }
 
 // GetAccessKeys retrieves all access keys from the server.
 // It returns a slice of access keys or an error if the operation fails.
+// A server without keys yields an empty non-nil slice, whether the response
+// omits the accessKeys field or holds an empty array.
 //
 // It returns [*ClientError] for unexpected HTTP status codes,
 // [*UnmarshalError] if JSON parsing fails,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) GetAccessKeys(ctx context.Context) ([]*types.AccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	req := &contracts.Request{
 		Method:  http.MethodGet,
 		URL:     c.getAccessKeysPath.String(),
 		Headers: DefaultHeaders(),
 	}
 
-	c.logRequest(ctx, "GetAccessKeys", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "GetAccessKeys", req)
 	if err != nil {
 		return nil, errDoGetAccessKeys(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return unmarshalAccessKeysResponse[types.AccessKey](resp.Body)
+		keys, err := unmarshalAccessKeysResponse[types.AccessKey](resp.Body, c.unmarshalOptions()...)
+		return c.withAccessURLHosts(ctx, keys, err)
+	default:
+		return nil, c.finishClientError(ctx, errStatusCode(opGetAccessKeys, resp.StatusCode, resp.Body))
+	}
+}
+
+// GetAccessKeysCreatedAfter retrieves all access keys and keeps those created after t.
+// The filter is applied client-side to [types.AccessKey.CreatedAt]. Keys without a creation time,
+// such as all keys of servers that do not report it, cannot be filtered and are always kept.
+//
+// It returns the errors of [Client.GetAccessKeys].
+func (c *Client) GetAccessKeysCreatedAfter(ctx context.Context, t time.Time) ([]*types.AccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	keys, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(keys, func(key *types.AccessKey) bool {
+		return key.CreatedAt != nil && !key.CreatedAt.After(t)
+	}), nil
+}
+
+// GetAccessKeysParsed retrieves all access keys and decodes their access URLs
+// with [types.AccessKey.ParseAccessURL], in the order the server lists the keys.
+// A key whose access URL cannot be parsed is still returned, with a nil Config and
+// the parse error, wrapping [types.InvalidAccessURLError], in its Err field.
+//
+// It returns the errors of [Client.GetAccessKeys].
+func (c *Client) GetAccessKeysParsed(ctx context.Context) ([]types.ParsedAccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	keys, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := make([]types.ParsedAccessKey, len(keys))
+	for i, key := range keys {
+		cfg, err := key.ParseAccessURL()
+		parsed[i] = types.ParsedAccessKey{Key: key, Config: cfg, Err: err}
+	}
+	return parsed, nil
+}
+
+// GetAccessKeysFields retrieves all access keys, asking the server to return only the given fields
+// through the fields query parameter (for example "id", "name" and "port").
+// Fields that are not selected are left zero-valued. Servers that ignore the parameter
+// return complete keys, which are decoded as usual. Empty fields request complete keys.
+//
+// It returns [*ClientError] for unexpected HTTP status codes,
+// [*UnmarshalError] if JSON parsing fails,
+// or [*DoError] if the HTTP request fails.
+func (c *Client) GetAccessKeysFields(ctx context.Context, fields []string) ([]*types.AccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	requestURL := *c.getAccessKeysPath
+	if len(fields) > 0 {
+		// The field list is comma-separated; commas are kept unescaped.
+		escaped := make([]string, len(fields))
+		for i, field := range fields {
+			escaped[i] = url.QueryEscape(field)
+		}
+		requestURL.RawQuery = "fields=" + strings.Join(escaped, ",")
+	}
+
+	req := &contracts.Request{
+		Method:  http.MethodGet,
+		URL:     requestURL.String(),
+		Headers: DefaultHeaders(),
+	}
+
+	resp, err := c.do(ctx, "GetAccessKeysFields", req)
+	if err != nil {
+		return nil, errDoGetAccessKeysFields(err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		keys, err := unmarshalAccessKeysResponse[types.AccessKey](resp.Body, c.unmarshalOptions()...)
+		return c.withAccessURLHosts(ctx, keys, err)
 	default:
-		return nil, errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return nil, c.finishClientError(ctx, errStatusCode(opGetAccessKeysFields, resp.StatusCode, resp.Body))
 	}
 }
 
@@ -80,43 +282,101 @@ func (c *Client) GetAccessKeys(ctx context.Context) ([]*types.AccessKey, error)
 // It returns the access key or an error if not found or if the operation fails.
 //
 // It returns [*ClientError] with code 404 if the access key is not found,
+// or nil and no error with [WithNotFoundAsNil],
 // [*ClientError] for other unexpected HTTP status codes,
 // [*UnmarshalError] if JSON parsing fails,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) GetAccessKey(ctx context.Context, accessKeyID string) (*types.AccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	key, err := c.getAccessKey(ctx, accessKeyID, c.notFoundAsNil)
+	if err != nil || key == nil {
+		return nil, err
+	}
+	return c.withAccessURLHost(ctx, key, nil)
+}
+
+// getAccessKey implements [Client.GetAccessKey]. A missing key yields nil and no error if notFoundAsNil is true.
+// Methods building on the key pass false, so they never see a nil key without an error.
+func (c *Client) getAccessKey(ctx context.Context, accessKeyID string, notFoundAsNil bool) (*types.AccessKey, error) {
 	req := &contracts.Request{
 		Method:  http.MethodGet,
-		URL:     setIDInPath(*c.getAccessKeyPath, accessKeyID),
+		URL:     c.idPath(c.getAccessKeyPath, accessKeyID),
 		Headers: DefaultHeaders(),
 	}
 
-	c.logRequest(ctx, "GetAccessKey", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "GetAccessKey", req)
 	if err != nil {
 		return nil, errDoGetAccessKey(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return unmarshalJSONWithError[types.AccessKey](resp.Body)
+		return unmarshalJSONWithError[types.AccessKey](resp.Body, c.unmarshalOptions()...)
 	case http.StatusNotFound:
-		return nil, errAccessKeyNotFound(http.StatusNotFound, accessKeyID)
+		if notFoundAsNil {
+			return nil, nil
+		}
+		return nil, c.finishClientError(ctx, errAccessKeyNotFound(opGetAccessKey, http.StatusNotFound, accessKeyID))
 	default:
-		return nil, errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return nil, c.finishClientError(ctx, errStatusCode(opGetAccessKey, resp.StatusCode, resp.Body))
+	}
+}
+
+// GetAccessKeyByName retrieves all access keys and returns the one whose name equals name.
+// The comparison is exact and case-sensitive.
+//
+// It returns [*ClientError] wrapping [AccessKeyNameNotFoundError] and [AccessKeyNotFoundError] if no key has the name,
+// [*ClientError] wrapping [MultipleAccessKeysError] listing the matching IDs if several keys share it,
+// and otherwise the errors of [Client.GetAccessKeys].
+func (c *Client) GetAccessKeyByName(ctx context.Context, name string) (*types.AccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	keys, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*types.AccessKey
+	for _, key := range keys {
+		if key.Name == name {
+			matches = append(matches, key)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, c.finishClientError(ctx, errAccessKeyNameNotFound(opGetAccessKeyByName, name))
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, key := range matches {
+			ids[i] = key.ID
+		}
+		return nil, c.finishClientError(ctx, errMultipleAccessKeys(opGetAccessKeyByName, name, ids))
 	}
 }
 
 // UpdateAccessKey updates an existing access key with the provided data.
 // It returns the updated access key or an error if not found or if the operation fails.
+// It sends PUT /access-keys/{id}, which Outline servers treat as [Client.CreateAccessKeyWithID]:
+// they refuse to overwrite an existing key. Use [Client.UpdateNameAccessKey],
+// [Client.UpdateDataLimitAccessKey] or [Client.UpdateAccessKeyPort] to change an existing key.
 //
 // It returns [*ClientError] with code 404 if the access key is not found,
+// [*ClientError] with code 409 wrapping [KeyAlreadyExistsError] if the server refuses to overwrite the key,
 // [*ClientError] for other unexpected HTTP status codes,
 // [*UnmarshalError] if JSON parsing fails,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) UpdateAccessKey(ctx context.Context, accessKeyID string,
 	updateAccessKey *types.AccessKey,
 ) (*types.AccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	var reqBodyBytes []byte
 
 	if updateAccessKey != nil {
@@ -125,26 +385,116 @@ func (c *Client) UpdateAccessKey(ctx context.Context, accessKeyID string,
 
 	req := &contracts.Request{
 		Method:  http.MethodPut,
-		URL:     setIDInPath(*c.putAccessKeyPath, accessKeyID),
+		URL:     c.idPath(c.putAccessKeyPath, accessKeyID),
 		Headers: DefaultHeaders(),
 		Body:    reqBodyBytes,
 	}
 
-	c.logRequest(ctx, "UpdateAccessKey", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "UpdateAccessKey", req)
 	if err != nil {
 		return nil, errDoUpdateAccessKey(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusCreated:
-		return unmarshalJSONWithError[types.AccessKey](resp.Body)
+		key, err := unmarshalJSONWithError[types.AccessKey](resp.Body, c.unmarshalOptions()...)
+		return c.withAccessURLHost(ctx, key, err)
 	case http.StatusNotFound:
-		return nil, errAccessKeyNotFound(http.StatusNotFound, accessKeyID)
+		return nil, c.finishClientError(ctx, errAccessKeyNotFound(opUpdateAccessKey, http.StatusNotFound, accessKeyID))
+	case http.StatusConflict:
+		return nil, c.finishClientError(ctx, errKeyAlreadyExists(opUpdateAccessKey, http.StatusConflict, accessKeyID))
 	default:
-		return nil, errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return nil, c.finishClientError(ctx, errStatusCode(opUpdateAccessKey, resp.StatusCode, resp.Body))
+	}
+}
+
+// UpdateAccessKeyPort moves an access key to another port and returns the new key.
+// Outline cannot change the port of an existing key, so the key is deleted and created again
+// with the same ID, name, password, method and data limit. The access URL changes with the port:
+// clients must import the new one.
+//
+// The replacement is not atomic. Between the deletion and the creation the key does not exist,
+// and its transfer metrics may be reset. If the key cannot be created on the new port,
+// UpdateAccessKeyPort creates it again with its previous configuration; if that fails too,
+// the key is lost and the returned error joins both failures.
+//
+// It returns [*ArgumentError] wrapping [InvalidPortError] if port is zero,
+// and otherwise the errors of [Client.GetAccessKey], [Client.DeleteAccessKey]
+// and [Client.CreateAccessKeyWithID].
+func (c *Client) UpdateAccessKeyPort(ctx context.Context, accessKeyID string, port uint16) (*types.AccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	if port == 0 {
+		return nil, errInvalidArgument("port", InvalidPortError)
+	}
+
+	return c.recreateAccessKey(ctx, accessKeyID, func(key *types.CreateAccessKey) {
+		key.Port = port
+	})
+}
+
+// recreateAccessKey replaces an access key by deleting it and creating it again with the same ID
+// and the configuration read from the server, as modified by change. If the replacement cannot be
+// created, the previous configuration is restored; the returned error joins both failures if the
+// restoration fails as well.
+func (c *Client) recreateAccessKey(ctx context.Context, accessKeyID string,
+	change func(key *types.CreateAccessKey),
+) (*types.AccessKey, error) {
+	key, err := c.getAccessKey(ctx, accessKeyID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := &types.CreateAccessKey{
+		Method:   key.Method,
+		Name:     key.Name,
+		Password: key.Password,
+		Port:     uint16(key.Port),
+		Limit:    key.Limit,
+	}
+	replacement := *previous
+	change(&replacement)
+
+	if err := c.DeleteAccessKey(ctx, accessKeyID); err != nil {
+		return nil, err
 	}
+
+	create := func(createAccessKey *types.CreateAccessKey) (*types.AccessKey, error) {
+		return c.withKeySlot(ctx, opCreateAccessKeyWithID, func() (*types.AccessKey, error) {
+			return c.createAccessKeyWithID(ctx, accessKeyID, createAccessKey)
+		})
+	}
+	recreated, err := create(&replacement)
+	if err != nil {
+		if _, restoreErr := create(previous); restoreErr != nil {
+			return nil, errors.Join(err, restoreErr)
+		}
+		return nil, err
+	}
+	return recreated, nil
+}
+
+// RotateAccessKeyPassword replaces the password of an access key and returns the new key
+// with its new access URL. An empty password asks the server to generate a new one.
+// Clients using the key lose their connection until they import the new access URL.
+//
+// Outline cannot change the password of an existing key, so, like [Client.UpdateAccessKeyPort],
+// RotateAccessKeyPassword deletes the key and creates it again with the same ID, name, port,
+// method and data limit. The replacement is not atomic: between the deletion and the creation
+// the key does not exist, and its transfer metrics may be reset. If the key cannot be created
+// with the new password, it is created again with its previous one; if that fails too,
+// the key is lost and the returned error joins both failures.
+//
+// It returns the errors of [Client.GetAccessKey], [Client.DeleteAccessKey]
+// and [Client.CreateAccessKeyWithID].
+func (c *Client) RotateAccessKeyPassword(ctx context.Context, accessKeyID, password string) (*types.AccessKey, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	return c.recreateAccessKey(ctx, accessKeyID, func(key *types.CreateAccessKey) {
+		key.Password = password
+	})
 }
 
 // DeleteAccessKey deletes an access key by its ID from the server.
@@ -154,32 +504,37 @@ func (c *Client) UpdateAccessKey(ctx context.Context, accessKeyID string,
 // [*ClientError] for other unexpected HTTP status codes,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) DeleteAccessKey(ctx context.Context, accessKeyID string) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	req := &contracts.Request{
 		Method:  http.MethodDelete,
-		URL:     setIDInPath(*c.deleteAccessKeyPath, accessKeyID),
+		URL:     c.idPath(c.deleteAccessKeyPath, accessKeyID),
 		Headers: DefaultHeaders(),
 	}
 
-	c.logRequest(ctx, "DeleteAccessKey", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "DeleteAccessKey", req)
 	if err != nil {
 		return errDoDeleteAccessKey(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusNoContent:
+		c.keyQuota.release()
 		return nil
 	case http.StatusNotFound:
-		return errAccessKeyNotFound(http.StatusNotFound, accessKeyID)
+		return c.finishClientError(ctx, errAccessKeyNotFound(opDeleteAccessKey, http.StatusNotFound, accessKeyID))
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opDeleteAccessKey, resp.StatusCode, resp.Body))
 	}
 }
 
 // === Management Operations for Access Keys ===
 
 func (c *Client) UpdateNameAccessKey(ctx context.Context, accessKeyID, newName string) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	var reqBody struct {
 		Name string `json:"name"`
 	}
@@ -189,14 +544,12 @@ func (c *Client) UpdateNameAccessKey(ctx context.Context, accessKeyID, newName s
 
 	req := &contracts.Request{
 		Method:  http.MethodPut,
-		URL:     setIDInPath(*c.putAccessKeyNamePath, accessKeyID),
+		URL:     c.idPath(c.putAccessKeyNamePath, accessKeyID),
 		Headers: DefaultHeaders(),
 		Body:    reqBodyBytes,
 	}
 
-	c.logRequest(ctx, "UpdateNameAccessKey", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "UpdateNameAccessKey", req)
 	if err != nil {
 		return errDoUpdateNameAccessKey(err)
 	}
@@ -205,9 +558,9 @@ func (c *Client) UpdateNameAccessKey(ctx context.Context, accessKeyID, newName s
 	case http.StatusNoContent:
 		return nil
 	case http.StatusNotFound:
-		return errAccessKeyNotFound(http.StatusNotFound, accessKeyID)
+		return c.finishClientError(ctx, errAccessKeyNotFound(opUpdateNameAccessKey, http.StatusNotFound, accessKeyID))
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opUpdateNameAccessKey, resp.StatusCode, resp.Body))
 	}
 }
 
@@ -221,6 +574,9 @@ func (c *Client) UpdateNameAccessKey(ctx context.Context, accessKeyID, newName s
 func (c *Client) UpdateDataLimitAccessKey(
 	ctx context.Context, accessKeyID string, bytes uint64,
 ) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	var reqBody struct {
 		Limit types.Limit `json:"limit"`
 	}
@@ -230,14 +586,12 @@ func (c *Client) UpdateDataLimitAccessKey(
 
 	req := &contracts.Request{
 		Method:  http.MethodPut,
-		URL:     setIDInPath(*c.putAccessKeyDataLimitPath, accessKeyID),
+		URL:     c.idPath(c.putAccessKeyDataLimitPath, accessKeyID),
 		Headers: DefaultHeaders(),
 		Body:    reqBodyBytes,
 	}
 
-	c.logRequest(ctx, "UpdateDataLimitAccessKey", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "UpdateDataLimitAccessKey", req)
 	if err != nil {
 		return errDoUpdateDataLimitAccessKey(err)
 	}
@@ -246,30 +600,67 @@ func (c *Client) UpdateDataLimitAccessKey(
 	case http.StatusNoContent:
 		return nil
 	case http.StatusBadRequest:
-		return errInvalidDataLimit(http.StatusBadRequest, bytes)
+		return c.finishClientError(ctx, errInvalidDataLimit(opUpdateDataLimitAccessKey, http.StatusBadRequest, bytes))
 	case http.StatusNotFound:
-		return errAccessKeyNotFound(http.StatusNotFound, accessKeyID)
+		return c.finishClientError(ctx, errAccessKeyNotFound(opUpdateDataLimitAccessKey, http.StatusNotFound, accessKeyID))
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opUpdateDataLimitAccessKey, resp.StatusCode, resp.Body))
 	}
 }
 
+// UpdateDataLimitAccessKeyVerified sets a data transfer limit for an access key like
+// [Client.UpdateDataLimitAccessKey] and then reads the key back to confirm the limit was applied.
+// Use [Client.UpdateDataLimitAccessKey] when the extra request is not wanted.
+//
+// It returns the errors of [Client.UpdateDataLimitAccessKey] and [Client.GetAccessKey],
+// or [*VerificationError] if the key read back has a different data limit.
+func (c *Client) UpdateDataLimitAccessKeyVerified(
+	ctx context.Context, accessKeyID string, bytes uint64,
+) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	if err := c.UpdateDataLimitAccessKey(ctx, accessKeyID, bytes); err != nil {
+		return err
+	}
+
+	key, err := c.getAccessKey(ctx, accessKeyID, false)
+	if err != nil {
+		return err
+	}
+
+	if key.Limit == nil {
+		return errVerification(accessKeyID, "dataLimit", strconv.FormatUint(bytes, 10), "none")
+	}
+	if key.Limit.Bytes != bytes {
+		return errVerification(accessKeyID, "dataLimit",
+			strconv.FormatUint(bytes, 10), strconv.FormatUint(key.Limit.Bytes, 10))
+	}
+
+	return nil
+}
+
 // DeleteDataLimitAccessKey removes the data transfer limit for an access key.
 // It returns an error if the access key is not found or if the operation fails.
 //
+// Some servers also answer 404 when the key exists but has no limit. With [WithIdempotentLimitDelete]
+// such a 404 is followed by a lookup of the key, and the call succeeds if the key exists.
+//
 // It returns [*ClientError] with code 404 if the access key is not found,
+// or no error with [WithNotFoundAsNil],
 // [*ClientError] for other unexpected HTTP status codes,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) DeleteDataLimitAccessKey(ctx context.Context, accessKeyID string) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	req := &contracts.Request{
 		Method:  http.MethodDelete,
-		URL:     setIDInPath(*c.deleteAccessKeyDataLimitPath, accessKeyID),
+		URL:     c.idPath(c.deleteAccessKeyDataLimitPath, accessKeyID),
 		Headers: DefaultHeaders(),
 	}
 
-	c.logRequest(ctx, "DeleteDataLimitAccessKey", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "DeleteDataLimitAccessKey", req)
 	if err != nil {
 		return errDoDeleteDataLimitAccessKey(err)
 	}
@@ -278,8 +669,24 @@ func (c *Client) DeleteDataLimitAccessKey(ctx context.Context, accessKeyID strin
 	case http.StatusNoContent:
 		return nil
 	case http.StatusNotFound:
-		return errAccessKeyNotFound(http.StatusNotFound, accessKeyID)
+		if c.notFoundAsNil {
+			return nil
+		}
+		if c.idempotentLimitDelete {
+			return c.checkLimitAlreadyAbsent(ctx, accessKeyID)
+		}
+		return c.finishClientError(ctx, errAccessKeyNotFound(opDeleteDataLimitAccessKey, http.StatusNotFound, accessKeyID))
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opDeleteDataLimitAccessKey, resp.StatusCode, resp.Body))
+	}
+}
+
+// checkLimitAlreadyAbsent resolves a 404 from [Client.DeleteDataLimitAccessKey]:
+// it succeeds if the key exists, as its limit is already absent, and reports the missing key otherwise.
+func (c *Client) checkLimitAlreadyAbsent(ctx context.Context, accessKeyID string) error {
+	_, err := c.getAccessKey(ctx, accessKeyID, false)
+	if errors.Is(err, AccessKeyNotFoundError) {
+		return c.finishClientError(ctx, errAccessKeyNotFound(opDeleteDataLimitAccessKey, http.StatusNotFound, accessKeyID))
 	}
+	return err
 }
@@ -1,12 +1,15 @@
 package outline
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/url"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/nepriyatelev/outline-client-go/internal/contracts"
 	"github.com/nepriyatelev/outline-client-go/outline/types"
@@ -52,7 +55,7 @@ func TestCreateAccessKey_Success(t *testing.T) {
 		{
 			name: "with all fields",
 			createAccessKey: &types.CreateAccessKey{
-				Method:   "aes-192-gcm",
+				Method:   "aes-128-gcm",
 				Name:     "Test Key",
 				Password: "securepassword123",
 				Port:     8388,
@@ -63,7 +66,7 @@ func TestCreateAccessKey_Success(t *testing.T) {
 				Name:      "Test Key",
 				Password:  "securepassword123",
 				Port:      8388,
-				Method:    "aes-192-gcm",
+				Method:    "aes-128-gcm",
 				AccessURL: "ss://test@example.com:8388",
 			},
 		},
@@ -128,7 +131,7 @@ func TestCreateAccessKey_Success(t *testing.T) {
 func TestCreateAccessKey_RequestBody(t *testing.T) {
 	// Arrange
 	createAccessKey := &types.CreateAccessKey{
-		Method:   "aes-192-gcm",
+		Method:   "aes-128-gcm",
 		Name:     "My Access Key",
 		Password: "mypassword",
 		Port:     9000,
@@ -140,7 +143,7 @@ func TestCreateAccessKey_RequestBody(t *testing.T) {
 		Name:      "My Access Key",
 		Password:  "mypassword",
 		Port:      9000,
-		Method:    "aes-192-gcm",
+		Method:    "aes-128-gcm",
 		AccessURL: "ss://test@example.com:9000",
 	}
 
@@ -173,6 +176,30 @@ func TestCreateAccessKey_RequestBody(t *testing.T) {
 	assert.Equal(t, createAccessKey.Limit.Bytes, sentBody.Limit.Bytes)
 }
 
+func TestCreateAccessKey_EchoedLimit(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+		StatusCode: http.StatusCreated,
+		Body: []byte(`{"id":"key-limit","name":"Limited","password":"pass","port":9000,` +
+			`"method":"aes-128-gcm","accessUrl":"ss://test@example.com:9000","dataLimit":{"bytes":10000}}`),
+	}, nil, nil)
+
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.CreateAccessKey(context.Background(), &types.CreateAccessKey{
+		Method: "aes-128-gcm",
+		Name:   "Limited",
+		Limit:  &types.Limit{Bytes: 10000},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.NotNil(t, result.Limit)
+	assert.Equal(t, uint64(10000), result.Limit.Bytes)
+}
+
 func TestCreateAccessKey_NilRequestBody(t *testing.T) {
 	// Arrange
 	expectedKey := types.AccessKey{
@@ -213,7 +240,7 @@ func TestCreateAccessKey_DoerError(t *testing.T) {
 	ctx := context.Background()
 
 	createAccessKey := &types.CreateAccessKey{
-		Method: "aes-192-gcm",
+		Method: "aes-128-gcm",
 	}
 
 	// Act
@@ -240,7 +267,7 @@ func TestCreateAccessKey_InvalidJSON(t *testing.T) {
 	ctx := context.Background()
 
 	createAccessKey := &types.CreateAccessKey{
-		Method: "aes-192-gcm",
+		Method: "aes-128-gcm",
 	}
 
 	// Act
@@ -266,7 +293,7 @@ func TestCreateAccessKey_EmptyBody(t *testing.T) {
 	ctx := context.Background()
 
 	createAccessKey := &types.CreateAccessKey{
-		Method: "aes-192-gcm",
+		Method: "aes-128-gcm",
 	}
 
 	// Act
@@ -283,34 +310,40 @@ func TestCreateAccessKey_EmptyBody(t *testing.T) {
 
 func TestCreateAccessKey_UnexpectedStatusCode(t *testing.T) {
 	tests := []struct {
-		name       string
-		statusCode int
-		body       []byte
+		name        string
+		statusCode  int
+		body        []byte
+		expectedErr error
 	}{
 		{
-			name:       "bad request",
-			statusCode: http.StatusBadRequest,
-			body:       []byte("Bad Request"),
+			name:        "bad request",
+			statusCode:  http.StatusBadRequest,
+			body:        []byte("Bad Request"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "internal server error",
-			statusCode: http.StatusInternalServerError,
-			body:       []byte("Internal Server Error"),
+			name:        "internal server error",
+			statusCode:  http.StatusInternalServerError,
+			body:        []byte("Internal Server Error"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "not found",
-			statusCode: http.StatusNotFound,
-			body:       []byte("Not Found"),
+			name:        "not found",
+			statusCode:  http.StatusNotFound,
+			body:        []byte("Not Found"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "teapot",
-			statusCode: http.StatusTeapot,
-			body:       []byte("I'm a teapot"),
+			name:        "teapot",
+			statusCode:  http.StatusTeapot,
+			body:        []byte("I'm a teapot"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "service unavailable",
-			statusCode: http.StatusServiceUnavailable,
-			body:       []byte("Service Unavailable"),
+			name:        "service unavailable",
+			statusCode:  http.StatusServiceUnavailable,
+			body:        []byte("Service Unavailable"),
+			expectedErr: ServiceUnavailableError,
 		},
 	}
 
@@ -326,7 +359,7 @@ func TestCreateAccessKey_UnexpectedStatusCode(t *testing.T) {
 			ctx := context.Background()
 
 			createAccessKey := &types.CreateAccessKey{
-				Method: "aes-192-gcm",
+				Method: "aes-128-gcm",
 			}
 
 			// Act
@@ -339,7 +372,7 @@ func TestCreateAccessKey_UnexpectedStatusCode(t *testing.T) {
 			assert.ErrorAs(t, err, &clientErr)
 			assert.Equal(t, tt.statusCode, clientErr.statusCode)
 			assert.ErrorIs(t, err, ClientOutlineError)
-			assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+			assert.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
@@ -351,7 +384,7 @@ func TestCreateAccessKey_Headers(t *testing.T) {
 		Name:      "Test",
 		Password:  "pass",
 		Port:      8080,
-		Method:    "aes-192-gcm",
+		Method:    "aes-128-gcm",
 		AccessURL: "ss://test@example.com:8080",
 	}
 
@@ -366,7 +399,7 @@ func TestCreateAccessKey_Headers(t *testing.T) {
 	ctx := context.Background()
 
 	createAccessKey := &types.CreateAccessKey{
-		Method: "aes-192-gcm",
+		Method: "aes-128-gcm",
 	}
 
 	// Act
@@ -394,7 +427,7 @@ func TestGetAccessKeys_Success(t *testing.T) {
 					Name:      "First Key",
 					Password:  "pass1",
 					Port:      8080,
-					Method:    "aes-192-gcm",
+					Method:    "aes-128-gcm",
 					AccessURL: "ss://first@example.com:8080",
 				},
 				{
@@ -529,36 +562,124 @@ func TestGetAccessKeys_EmptyBody(t *testing.T) {
 	assert.ErrorIs(t, err, UnmarshalEmptyBodyError)
 }
 
+func TestGetAccessKeys_NoKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "missing field", body: `{}`},
+		{name: "empty array", body: `{"accessKeys":[]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+				StatusCode: http.StatusOK,
+				Body:       []byte(tt.body),
+			}, nil, nil)
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			result, err := client.GetAccessKeys(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []*types.AccessKey{}, result)
+		})
+	}
+}
+
+func TestGetAccessKeysCreatedAfter(t *testing.T) {
+	cutoff := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		body        string
+		expectedIDs []string
+	}{
+		{
+			name: "timestamps present",
+			body: `{"accessKeys":[` +
+				`{"id":"1","createdAt":"2025-01-01T00:00:00Z"},` +
+				`{"id":"2","createdAt":"2025-07-01T00:00:00Z","modifiedAt":"2025-08-01T00:00:00Z"},` +
+				`{"id":"3","createdAt":"2025-06-01T00:00:00Z"}]}`,
+			expectedIDs: []string{"2"},
+		},
+		{
+			name: "mixed set",
+			body: `{"accessKeys":[` +
+				`{"id":"1","createdAt":"2025-01-01T00:00:00Z"},` +
+				`{"id":"2"},` +
+				`{"id":"3","createdAt":"2025-09-01T00:00:00Z"}]}`,
+			expectedIDs: []string{"2", "3"},
+		},
+		{
+			name:        "timestamps absent",
+			body:        `{"accessKeys":[{"id":"1"},{"id":"2"}]}`,
+			expectedIDs: []string{"1", "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+				StatusCode: http.StatusOK,
+				Body:       []byte(tt.body),
+			}, nil, nil)
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			keys, err := client.GetAccessKeysCreatedAfter(context.Background(), cutoff)
+
+			// Assert
+			require.NoError(t, err)
+			ids := make([]string, 0, len(keys))
+			for _, key := range keys {
+				ids = append(ids, key.ID)
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+		})
+	}
+}
+
 func TestGetAccessKeys_UnexpectedStatusCode(t *testing.T) {
 	tests := []struct {
-		name       string
-		statusCode int
-		body       []byte
+		name        string
+		statusCode  int
+		body        []byte
+		expectedErr error
 	}{
 		{
-			name:       "bad request",
-			statusCode: http.StatusBadRequest,
-			body:       []byte("Bad Request"),
+			name:        "bad request",
+			statusCode:  http.StatusBadRequest,
+			body:        []byte("Bad Request"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "internal server error",
-			statusCode: http.StatusInternalServerError,
-			body:       []byte("Internal Server Error"),
+			name:        "internal server error",
+			statusCode:  http.StatusInternalServerError,
+			body:        []byte("Internal Server Error"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "not found",
-			statusCode: http.StatusNotFound,
-			body:       []byte("Not Found"),
+			name:        "not found",
+			statusCode:  http.StatusNotFound,
+			body:        []byte("Not Found"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "unauthorized",
-			statusCode: http.StatusUnauthorized,
-			body:       []byte("Unauthorized"),
+			name:        "unauthorized",
+			statusCode:  http.StatusUnauthorized,
+			body:        []byte("Unauthorized"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "service unavailable",
-			statusCode: http.StatusServiceUnavailable,
-			body:       []byte("Service Unavailable"),
+			name:        "service unavailable",
+			statusCode:  http.StatusServiceUnavailable,
+			body:        []byte("Service Unavailable"),
+			expectedErr: ServiceUnavailableError,
 		},
 	}
 
@@ -583,7 +704,43 @@ func TestGetAccessKeys_UnexpectedStatusCode(t *testing.T) {
 			assert.ErrorAs(t, err, &clientErr)
 			assert.Equal(t, tt.statusCode, clientErr.statusCode)
 			assert.ErrorIs(t, err, ClientOutlineError)
-			assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestGetAccessKeys_GatewayErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+	}{
+		{name: "bad gateway", statusCode: http.StatusBadGateway},
+		{name: "service unavailable", statusCode: http.StatusServiceUnavailable},
+		{name: "gateway timeout", statusCode: http.StatusGatewayTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+				StatusCode: tt.statusCode,
+				Body:       []byte(http.StatusText(tt.statusCode)),
+			}, nil, nil)
+
+			client := createTestClientForAccessKeys(mockDoer)
+			ctx := context.Background()
+
+			// Act
+			result, err := client.GetAccessKeys(ctx)
+
+			// Assert
+			assert.Nil(t, result)
+			var clientErr *ClientError
+			require.ErrorAs(t, err, &clientErr)
+			assert.Equal(t, tt.statusCode, clientErr.statusCode)
+			assert.ErrorIs(t, err, ClientOutlineError)
+			assert.ErrorIs(t, err, ServiceUnavailableError)
+			assert.NotErrorIs(t, err, UnexpectedStatusCodeError)
 		})
 	}
 }
@@ -636,7 +793,8 @@ func TestGetAccessKeys_NilResponseKeys(t *testing.T) {
 
 	// Assert
 	require.NoError(t, err)
-	assert.Nil(t, result)
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
 }
 
 // === GetAccessKey Tests ===
@@ -655,7 +813,7 @@ func TestGetAccessKey_Success(t *testing.T) {
 				Name:      "Test Key",
 				Password:  "securepassword",
 				Port:      8388,
-				Method:    "aes-192-gcm",
+				Method:    "aes-128-gcm",
 				AccessURL: "ss://test@example.com:8388",
 			},
 		},
@@ -739,6 +897,49 @@ func TestGetAccessKey_NotFound(t *testing.T) {
 	assert.ErrorIs(t, err, AccessKeyNotFoundError)
 }
 
+func TestGetAccessKey_NotFoundAsNil(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     []Option
+		expectedErr error
+	}{
+		{name: "strict default", expectedErr: AccessKeyNotFoundError},
+		{name: "not found as nil", options: []Option{WithNotFoundAsNil()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoerAccessKey(t, &contracts.Response{StatusCode: http.StatusNotFound}, nil, nil)
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+
+			// Act
+			result, err := client.GetAccessKey(context.Background(), "missing")
+
+			// Assert
+			assert.Nil(t, result)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdateAccessKeyPort_NotFoundWithNotFoundAsNil(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{StatusCode: http.StatusNotFound}, nil, nil)
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithNotFoundAsNil())
+
+	// Act
+	result, err := client.UpdateAccessKeyPort(context.Background(), "missing", 9000)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, AccessKeyNotFoundError)
+}
+
 func TestGetAccessKey_DoerError(t *testing.T) {
 	// Arrange
 	networkError := errors.New("network error")
@@ -806,34 +1007,40 @@ func TestGetAccessKey_EmptyBody(t *testing.T) {
 
 func TestGetAccessKey_UnexpectedStatusCode(t *testing.T) {
 	tests := []struct {
-		name       string
-		statusCode int
-		body       []byte
+		name        string
+		statusCode  int
+		body        []byte
+		expectedErr error
 	}{
 		{
-			name:       "bad request",
-			statusCode: http.StatusBadRequest,
-			body:       []byte("Bad Request"),
+			name:        "bad request",
+			statusCode:  http.StatusBadRequest,
+			body:        []byte("Bad Request"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "internal server error",
-			statusCode: http.StatusInternalServerError,
-			body:       []byte("Internal Server Error"),
+			name:        "internal server error",
+			statusCode:  http.StatusInternalServerError,
+			body:        []byte("Internal Server Error"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "unauthorized",
-			statusCode: http.StatusUnauthorized,
-			body:       []byte("Unauthorized"),
+			name:        "unauthorized",
+			statusCode:  http.StatusUnauthorized,
+			body:        []byte("Unauthorized"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "forbidden",
-			statusCode: http.StatusForbidden,
-			body:       []byte("Forbidden"),
+			name:        "forbidden",
+			statusCode:  http.StatusForbidden,
+			body:        []byte("Forbidden"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "service unavailable",
-			statusCode: http.StatusServiceUnavailable,
-			body:       []byte("Service Unavailable"),
+			name:        "service unavailable",
+			statusCode:  http.StatusServiceUnavailable,
+			body:        []byte("Service Unavailable"),
+			expectedErr: ServiceUnavailableError,
 		},
 	}
 
@@ -858,7 +1065,7 @@ func TestGetAccessKey_UnexpectedStatusCode(t *testing.T) {
 			assert.ErrorAs(t, err, &clientErr)
 			assert.Equal(t, tt.statusCode, clientErr.statusCode)
 			assert.ErrorIs(t, err, ClientOutlineError)
-			assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+			assert.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
@@ -870,7 +1077,7 @@ func TestGetAccessKey_Headers(t *testing.T) {
 		Name:      "Test",
 		Password:  "pass",
 		Port:      8080,
-		Method:    "aes-192-gcm",
+		Method:    "aes-128-gcm",
 		AccessURL: "ss://test@example.com:8080",
 	}
 
@@ -901,7 +1108,7 @@ func TestGetAccessKey_EmptyAccessKeyID(t *testing.T) {
 		Name:      "Empty ID Key",
 		Password:  "pass",
 		Port:      8080,
-		Method:    "aes-192-gcm",
+		Method:    "aes-128-gcm",
 		AccessURL: "ss://test@example.com:8080",
 	}
 
@@ -951,7 +1158,7 @@ func TestGetAccessKey_SpecialCharactersInID(t *testing.T) {
 				Name:      "Special Key",
 				Password:  "pass",
 				Port:      8080,
-				Method:    "aes-192-gcm",
+				Method:    "aes-128-gcm",
 				AccessURL: "ss://test@example.com:8080",
 			}
 
@@ -976,6 +1183,111 @@ func TestGetAccessKey_SpecialCharactersInID(t *testing.T) {
 	}
 }
 
+// === GetAccessKeyByName Tests ===
+
+func TestGetAccessKeyByName(t *testing.T) {
+	keys := []*types.AccessKey{
+		{ID: "1", Name: "Alice"},
+		{ID: "2", Name: "Bob"},
+		{ID: "3", Name: "bob"},
+		{ID: "4", Name: "Carol"},
+		{ID: "5", Name: "Carol"},
+	}
+
+	tests := []struct {
+		name        string
+		lookup      string
+		expectedID  string
+		expectedErr error
+		notErr      error
+	}{
+		{name: "single match", lookup: "Alice", expectedID: "1"},
+		{name: "case-sensitive match", lookup: "bob", expectedID: "3"},
+		{name: "no match", lookup: "alice", expectedErr: AccessKeyNameNotFoundError, notErr: MultipleAccessKeysError},
+		{name: "duplicate match", lookup: "Carol", expectedErr: MultipleAccessKeysError, notErr: AccessKeyNotFoundError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+				return jsonResponse(http.StatusOK, map[string]any{"accessKeys": keys}), nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			key, err := client.GetAccessKeyByName(context.Background(), tt.lookup)
+
+			// Assert
+			if tt.expectedErr != nil {
+				assert.Nil(t, key)
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.ErrorIs(t, err, ClientOutlineError)
+				assert.NotErrorIs(t, err, tt.notErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedID, key.ID)
+		})
+	}
+}
+
+func TestGetAccessKeyByName_ErrorMessages(t *testing.T) {
+	// Arrange
+	keys := []*types.AccessKey{{ID: "4", Name: "Carol"}, {ID: "5", Name: "Carol"}}
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return jsonResponse(http.StatusOK, map[string]any{"accessKeys": keys}), nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	_, notFoundErr := client.GetAccessKeyByName(context.Background(), "Dave")
+	_, multipleErr := client.GetAccessKeyByName(context.Background(), "Carol")
+
+	// Assert
+	require.ErrorIs(t, notFoundErr, AccessKeyNotFoundError)
+	assert.Contains(t, notFoundErr.Error(), `access key name: "Dave"`)
+	require.Error(t, multipleErr)
+	assert.Contains(t, multipleErr.Error(), `access key name: "Carol", access key ids: 4, 5`)
+	for _, err := range []error{notFoundErr, multipleErr} {
+		var clientErr *ClientError
+		require.ErrorAs(t, err, &clientErr)
+		assert.Equal(t, opGetAccessKeyByName, clientErr.Operation())
+		assert.Zero(t, clientErr.StatusCode())
+		assert.NotContains(t, err.Error(), "status code")
+	}
+}
+
+func TestGetAccessKeyByName_ServerName(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return jsonResponse(http.StatusOK, map[string]any{"accessKeys": []*types.AccessKey{}}), nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithClientName("eu-1"))
+
+	// Act
+	_, err := client.GetAccessKeyByName(context.Background(), "Dave")
+
+	// Assert
+	var clientErr *ClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, "eu-1", clientErr.ServerName())
+}
+
+func TestGetAccessKeyByName_GetAccessKeysError(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{StatusCode: http.StatusInternalServerError}, nil, nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	key, err := client.GetAccessKeyByName(context.Background(), "Alice")
+
+	// Assert
+	assert.Nil(t, key)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+	assert.NotErrorIs(t, err, AccessKeyNameNotFoundError)
+}
+
 // === UpdateAccessKey Tests ===
 
 func TestUpdateAccessKey_Success(t *testing.T) {
@@ -1017,7 +1329,7 @@ func TestUpdateAccessKey_Success(t *testing.T) {
 				Name:      "New Name Only",
 				Password:  "existing-pass",
 				Port:      8080,
-				Method:    "aes-192-gcm",
+				Method:    "aes-128-gcm",
 				AccessURL: "ss://existing@example.com:8080",
 			},
 		},
@@ -1074,7 +1386,7 @@ func TestUpdateAccessKey_RequestBody(t *testing.T) {
 		Name:      "Body Test Key",
 		Password:  "testpassword",
 		Port:      9500,
-		Method:    "aes-192-gcm",
+		Method:    "aes-128-gcm",
 		AccessURL: "ss://body@example.com:9500",
 	}
 
@@ -1113,7 +1425,7 @@ func TestUpdateAccessKey_NilRequestBody(t *testing.T) {
 		Name:      "Nil Body Key",
 		Password:  "generated",
 		Port:      8080,
-		Method:    "aes-192-gcm",
+		Method:    "aes-128-gcm",
 		AccessURL: "ss://nil@example.com:8080",
 	}
 
@@ -1248,34 +1560,40 @@ func TestUpdateAccessKey_EmptyBody(t *testing.T) {
 
 func TestUpdateAccessKey_UnexpectedStatusCode(t *testing.T) {
 	tests := []struct {
-		name       string
-		statusCode int
-		body       []byte
+		name        string
+		statusCode  int
+		body        []byte
+		expectedErr error
 	}{
 		{
-			name:       "bad request",
-			statusCode: http.StatusBadRequest,
-			body:       []byte("Bad Request"),
+			name:        "bad request",
+			statusCode:  http.StatusBadRequest,
+			body:        []byte("Bad Request"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "internal server error",
-			statusCode: http.StatusInternalServerError,
-			body:       []byte("Internal Server Error"),
+			name:        "internal server error",
+			statusCode:  http.StatusInternalServerError,
+			body:        []byte("Internal Server Error"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "unauthorized",
-			statusCode: http.StatusUnauthorized,
-			body:       []byte("Unauthorized"),
+			name:        "unauthorized",
+			statusCode:  http.StatusUnauthorized,
+			body:        []byte("Unauthorized"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "forbidden",
-			statusCode: http.StatusForbidden,
-			body:       []byte("Forbidden"),
+			name:        "forbidden",
+			statusCode:  http.StatusForbidden,
+			body:        []byte("Forbidden"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "service unavailable",
-			statusCode: http.StatusServiceUnavailable,
-			body:       []byte("Service Unavailable"),
+			name:        "service unavailable",
+			statusCode:  http.StatusServiceUnavailable,
+			body:        []byte("Service Unavailable"),
+			expectedErr: ServiceUnavailableError,
 		},
 	}
 
@@ -1305,7 +1623,7 @@ func TestUpdateAccessKey_UnexpectedStatusCode(t *testing.T) {
 			assert.ErrorAs(t, err, &clientErr)
 			assert.Equal(t, tt.statusCode, clientErr.statusCode)
 			assert.ErrorIs(t, err, ClientOutlineError)
-			assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+			assert.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
@@ -1317,7 +1635,7 @@ func TestUpdateAccessKey_Headers(t *testing.T) {
 		Name:      "Headers Test",
 		Password:  "pass",
 		Port:      8080,
-		Method:    "aes-192-gcm",
+		Method:    "aes-128-gcm",
 		AccessURL: "ss://test@example.com:8080",
 	}
 
@@ -1353,7 +1671,7 @@ func TestUpdateAccessKey_EmptyAccessKeyID(t *testing.T) {
 		Name:      "Empty ID Key",
 		Password:  "pass",
 		Port:      8080,
-		Method:    "aes-192-gcm",
+		Method:    "aes-128-gcm",
 		AccessURL: "ss://test@example.com:8080",
 	}
 
@@ -1468,34 +1786,40 @@ func TestDeleteAccessKey_DoerError(t *testing.T) {
 
 func TestDeleteAccessKey_UnexpectedStatusCode(t *testing.T) {
 	tests := []struct {
-		name       string
-		statusCode int
-		body       []byte
+		name        string
+		statusCode  int
+		body        []byte
+		expectedErr error
 	}{
 		{
-			name:       "bad request",
-			statusCode: http.StatusBadRequest,
-			body:       []byte("Bad Request"),
+			name:        "bad request",
+			statusCode:  http.StatusBadRequest,
+			body:        []byte("Bad Request"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "internal server error",
-			statusCode: http.StatusInternalServerError,
-			body:       []byte("Internal Server Error"),
+			name:        "internal server error",
+			statusCode:  http.StatusInternalServerError,
+			body:        []byte("Internal Server Error"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "unauthorized",
-			statusCode: http.StatusUnauthorized,
-			body:       []byte("Unauthorized"),
+			name:        "unauthorized",
+			statusCode:  http.StatusUnauthorized,
+			body:        []byte("Unauthorized"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "forbidden",
-			statusCode: http.StatusForbidden,
-			body:       []byte("Forbidden"),
+			name:        "forbidden",
+			statusCode:  http.StatusForbidden,
+			body:        []byte("Forbidden"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "service unavailable",
-			statusCode: http.StatusServiceUnavailable,
-			body:       []byte("Service Unavailable"),
+			name:        "service unavailable",
+			statusCode:  http.StatusServiceUnavailable,
+			body:        []byte("Service Unavailable"),
+			expectedErr: ServiceUnavailableError,
 		},
 	}
 
@@ -1519,7 +1843,7 @@ func TestDeleteAccessKey_UnexpectedStatusCode(t *testing.T) {
 			assert.ErrorAs(t, err, &clientErr)
 			assert.Equal(t, tt.statusCode, clientErr.statusCode)
 			assert.ErrorIs(t, err, ClientOutlineError)
-			assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+			assert.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
@@ -1731,34 +2055,40 @@ func TestUpdateNameAccessKey_DoerError(t *testing.T) {
 
 func TestUpdateNameAccessKey_UnexpectedStatusCode(t *testing.T) {
 	tests := []struct {
-		name       string
-		statusCode int
-		body       []byte
+		name        string
+		statusCode  int
+		body        []byte
+		expectedErr error
 	}{
 		{
-			name:       "bad request",
-			statusCode: http.StatusBadRequest,
-			body:       []byte("Bad Request"),
+			name:        "bad request",
+			statusCode:  http.StatusBadRequest,
+			body:        []byte("Bad Request"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "internal server error",
-			statusCode: http.StatusInternalServerError,
-			body:       []byte("Internal Server Error"),
+			name:        "internal server error",
+			statusCode:  http.StatusInternalServerError,
+			body:        []byte("Internal Server Error"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "unauthorized",
-			statusCode: http.StatusUnauthorized,
-			body:       []byte("Unauthorized"),
+			name:        "unauthorized",
+			statusCode:  http.StatusUnauthorized,
+			body:        []byte("Unauthorized"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "forbidden",
-			statusCode: http.StatusForbidden,
-			body:       []byte("Forbidden"),
+			name:        "forbidden",
+			statusCode:  http.StatusForbidden,
+			body:        []byte("Forbidden"),
+			expectedErr: UnexpectedStatusCodeError,
 		},
 		{
-			name:       "service unavailable",
-			statusCode: http.StatusServiceUnavailable,
-			body:       []byte("Service Unavailable"),
+			name:        "service unavailable",
+			statusCode:  http.StatusServiceUnavailable,
+			body:        []byte("Service Unavailable"),
+			expectedErr: ServiceUnavailableError,
 		},
 	}
 
@@ -1782,7 +2112,7 @@ func TestUpdateNameAccessKey_UnexpectedStatusCode(t *testing.T) {
 			assert.ErrorAs(t, err, &clientErr)
 			assert.Equal(t, tt.statusCode, clientErr.statusCode)
 			assert.ErrorIs(t, err, ClientOutlineError)
-			assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+			assert.ErrorIs(t, err, tt.expectedErr)
 		})
 	}
 }
@@ -1896,6 +2226,79 @@ func TestDeleteDataLimitAccessKey_NotFound(t *testing.T) {
 	assert.Contains(t, req.URL, accessKeyID)
 }
 
+func TestDeleteDataLimitAccessKey_NotFoundAsNil(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{StatusCode: http.StatusNotFound}, nil, nil)
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithNotFoundAsNil())
+
+	// Act
+	err := client.DeleteDataLimitAccessKey(context.Background(), "missing")
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestDeleteDataLimitAccessKey_IdempotentLimitDelete(t *testing.T) {
+	tests := []struct {
+		name          string
+		options       []Option
+		keyStatus     int
+		expectedErr   error
+		expectedCalls []string
+	}{
+		{
+			name:          "strict default",
+			keyStatus:     http.StatusOK,
+			expectedErr:   AccessKeyNotFoundError,
+			expectedCalls: []string{"DELETE /access-keys/1/data-limit"},
+		},
+		{
+			name:          "limit already absent",
+			options:       []Option{WithIdempotentLimitDelete()},
+			keyStatus:     http.StatusOK,
+			expectedCalls: []string{"DELETE /access-keys/1/data-limit", "GET /access-keys/1"},
+		},
+		{
+			name:          "key missing",
+			options:       []Option{WithIdempotentLimitDelete()},
+			keyStatus:     http.StatusNotFound,
+			expectedErr:   AccessKeyNotFoundError,
+			expectedCalls: []string{"DELETE /access-keys/1/data-limit", "GET /access-keys/1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				if req.Method == http.MethodGet {
+					if tt.keyStatus != http.StatusOK {
+						return &contracts.Response{StatusCode: tt.keyStatus}, nil
+					}
+					return jsonResponse(http.StatusOK, types.AccessKey{ID: "1"}), nil
+				}
+				return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+
+			// Act
+			err := client.DeleteDataLimitAccessKey(context.Background(), "1")
+
+			// Assert
+			assert.Equal(t, tt.expectedCalls, calls)
+			if tt.expectedErr != nil {
+				var clientErr *ClientError
+				require.ErrorAs(t, err, &clientErr)
+				assert.Equal(t, opDeleteDataLimitAccessKey, clientErr.Operation())
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestDeleteDataLimitAccessKey_DoerError(t *testing.T) {
 	// Arrange
 	accessKeyID := "key-doer-error"
@@ -2158,3 +2561,686 @@ func TestUpdateDataLimitAccessKey_UnexpectedStatusCode(t *testing.T) {
 		})
 	}
 }
+
+// === CreateAccessKeyWithID Tests ===
+
+func TestCreateAccessKeyWithID_Success(t *testing.T) {
+	// Arrange
+	expectedKey := types.AccessKey{
+		ID:        "my-key",
+		Name:      "Chosen",
+		Password:  "pass",
+		Port:      9000,
+		Method:    "aes-256-gcm",
+		AccessURL: "ss://chosen@example.com:9000",
+	}
+	respBody, _ := json.Marshal(expectedKey)
+	var capturedReq *contracts.Request
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+		StatusCode: http.StatusCreated,
+		Body:       respBody,
+	}, nil, &capturedReq)
+
+	client := createTestClientForAccessKeys(mockDoer)
+	ctx := context.Background()
+
+	// Act
+	result, err := client.CreateAccessKeyWithID(ctx, "my-key", &types.CreateAccessKey{
+		Method: "aes-256-gcm",
+		Name:   "Chosen",
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, expectedKey, *result)
+	assert.Equal(t, http.MethodPut, capturedReq.Method)
+	assert.Equal(t, "http://localhost:8081/api/access-keys/my-key", capturedReq.URL)
+}
+
+func TestCreateAccessKeyWithID_RequestBody(t *testing.T) {
+	// Arrange
+	respBody, _ := json.Marshal(types.AccessKey{ID: "migrated-1", Method: "aes-128-gcm", Port: 8388})
+	var capturedReq *contracts.Request
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+		StatusCode: http.StatusCreated,
+		Body:       respBody,
+	}, nil, &capturedReq)
+
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	_, err := client.CreateAccessKeyWithID(context.Background(), "migrated-1", &types.CreateAccessKey{
+		Method:   "aes-128-gcm",
+		Name:     "Migrated",
+		Password: "secret",
+		Port:     8388,
+		Limit:    &types.Limit{Bytes: 5000},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, capturedReq)
+	assert.JSONEq(t,
+		`{"method":"aes-128-gcm","name":"Migrated","password":"secret","port":8388,"limit":{"bytes":5000}}`,
+		string(capturedReq.Body))
+}
+
+func TestCreateAccessKeyWithID_Conflict(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+		StatusCode: http.StatusConflict,
+		Body:       []byte("Conflict"),
+	}, nil, nil)
+
+	client := createTestClientForAccessKeys(mockDoer)
+	ctx := context.Background()
+
+	// Act
+	result, err := client.CreateAccessKeyWithID(ctx, "existing-key", &types.CreateAccessKey{Method: "aes-256-gcm"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	var clientErr *ClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, http.StatusConflict, clientErr.statusCode)
+	assert.Contains(t, clientErr.Error(), "existing-key")
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, KeyAlreadyExistsError)
+}
+
+func TestCreateAccessKeyWithID_DoerError(t *testing.T) {
+	// Arrange
+	networkError := errors.New("network error")
+	mockDoer := newMockDoerAccessKey(t, nil, networkError, nil)
+
+	client := createTestClientForAccessKeys(mockDoer)
+	ctx := context.Background()
+
+	// Act
+	result, err := client.CreateAccessKeyWithID(ctx, "my-key", nil)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	var doErr *DoError
+	assert.ErrorAs(t, err, &doErr)
+	assert.ErrorIs(t, err, DoOperationError)
+	assert.ErrorIs(t, err, networkError)
+}
+
+func TestCreateAccessKeyWithID_UnexpectedStatusCode(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+		StatusCode: http.StatusInternalServerError,
+		Body:       []byte("Internal Server Error"),
+	}, nil, nil)
+
+	client := createTestClientForAccessKeys(mockDoer)
+	ctx := context.Background()
+
+	// Act
+	result, err := client.CreateAccessKeyWithID(ctx, "my-key", nil)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+	assert.NotErrorIs(t, err, KeyAlreadyExistsError)
+}
+
+func TestCreateAccessKey_ConflictUnaffected(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+		StatusCode: http.StatusConflict,
+		Body:       []byte("Conflict"),
+	}, nil, nil)
+
+	client := createTestClientForAccessKeys(mockDoer)
+	ctx := context.Background()
+
+	// Act
+	result, err := client.CreateAccessKey(ctx, &types.CreateAccessKey{Method: "aes-256-gcm"})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+	assert.NotErrorIs(t, err, KeyAlreadyExistsError)
+}
+
+func TestCreateAccessKey_EncryptionMethod(t *testing.T) {
+	tests := []struct {
+		name              string
+		method            string
+		serverMethods     []string
+		expectedServerGet bool
+		expectedErr       error
+	}{
+		{name: "valid method", method: types.MethodAES256GCM},
+		{name: "empty method uses server default", method: ""},
+		{
+			name:              "method reported by the server",
+			method:            "aes-192-gcm",
+			serverMethods:     []string{"aes-192-gcm", types.MethodAES256GCM},
+			expectedServerGet: true,
+		},
+		{
+			name:              "invalid method",
+			method:            "rc4-md5",
+			serverMethods:     []string{"aes-192-gcm"},
+			expectedServerGet: true,
+			expectedErr:       InvalidEncryptionMethodError,
+		},
+		{
+			name:              "invalid method, server reports no methods",
+			method:            "rc4-md5",
+			expectedServerGet: true,
+			expectedErr:       InvalidEncryptionMethodError,
+		},
+	}
+
+	create := map[string]func(c *Client, createAccessKey *types.CreateAccessKey) (*types.AccessKey, error){
+		"CreateAccessKey": func(c *Client, createAccessKey *types.CreateAccessKey) (*types.AccessKey, error) {
+			return c.CreateAccessKey(context.Background(), createAccessKey)
+		},
+		"CreateAccessKeyWithID": func(c *Client, createAccessKey *types.CreateAccessKey) (*types.AccessKey, error) {
+			return c.CreateAccessKeyWithID(context.Background(), "my-key", createAccessKey)
+		},
+	}
+
+	for _, tt := range tests {
+		for op, call := range create {
+			t.Run(op+"/"+tt.name, func(t *testing.T) {
+				// Arrange
+				var calls []string
+				mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+					if requestPath(req) == "/server" {
+						return jsonResponse(http.StatusOK, types.ServerInfoResponse{SupportedEncryptionMethods: tt.serverMethods}), nil
+					}
+					return jsonResponse(http.StatusCreated, types.AccessKey{ID: "my-key", Method: tt.method}), nil
+				})
+				client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer))
+
+				// Act
+				result, err := call(client, &types.CreateAccessKey{Method: tt.method})
+
+				// Assert
+				assert.Equal(t, tt.expectedServerGet, slices.Contains(calls, "GET /server"))
+				if tt.expectedErr == nil {
+					require.NoError(t, err)
+					assert.NotNil(t, result)
+					return
+				}
+				assert.Nil(t, result)
+				var argErr *ArgumentError
+				require.ErrorAs(t, err, &argErr)
+				assert.ErrorIs(t, err, InvalidArgumentError)
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Contains(t, err.Error(), `"rc4-md5"`)
+				assert.Equal(t, []string{"GET /server"}, calls)
+			})
+		}
+	}
+}
+
+func TestCreateAccessKey_EncryptionMethodServerInfoError(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer))
+
+	// Act
+	result, err := client.CreateAccessKey(context.Background(), &types.CreateAccessKey{Method: "aes-192-gcm"})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+	assert.Equal(t, []string{"GET /server"}, calls)
+}
+
+// === UpdateDataLimitAccessKeyVerified Tests ===
+
+func TestUpdateDataLimitAccessKeyVerified(t *testing.T) {
+	tests := []struct {
+		name        string
+		readBack    types.AccessKey
+		expectedErr error
+		expectedMsg string
+	}{
+		{
+			name:     "limit matches",
+			readBack: types.AccessKey{ID: "1", Limit: &types.Limit{Bytes: 1000}},
+		},
+		{
+			name:        "limit differs",
+			readBack:    types.AccessKey{ID: "1", Limit: &types.Limit{Bytes: 500}},
+			expectedErr: VerificationFailedError,
+			expectedMsg: "expected: 1000; actual: 500",
+		},
+		{
+			name:        "limit missing",
+			readBack:    types.AccessKey{ID: "1"},
+			expectedErr: VerificationFailedError,
+			expectedMsg: "expected: 1000; actual: none",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				if req.Method == http.MethodPut {
+					return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+				}
+				return jsonResponse(http.StatusOK, tt.readBack), nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			err := client.UpdateDataLimitAccessKeyVerified(context.Background(), "1", 1000)
+
+			// Assert
+			assert.Equal(t, []string{"PUT /access-keys/1/data-limit", "GET /access-keys/1"}, calls)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			var verificationErr *VerificationError
+			require.ErrorAs(t, err, &verificationErr)
+			assert.ErrorIs(t, err, ClientOutlineError)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Contains(t, err.Error(), tt.expectedMsg)
+		})
+	}
+}
+
+func TestUpdateDataLimitAccessKeyVerified_UpdateFails(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	err := client.UpdateDataLimitAccessKeyVerified(context.Background(), "1", 1000)
+
+	// Assert
+	assert.ErrorIs(t, err, AccessKeyNotFoundError)
+	assert.Equal(t, []string{"PUT /access-keys/1/data-limit"}, calls)
+}
+
+// === GetAccessKeysFields Tests ===
+
+func TestGetAccessKeysFields(t *testing.T) {
+	tests := []struct {
+		name          string
+		fields        []string
+		body          string
+		expectedQuery string
+		expected      []*types.AccessKey
+	}{
+		{
+			name:          "partial response",
+			fields:        []string{"id", "name", "port"},
+			body:          `{"accessKeys":[{"id":"1","name":"alice","port":8080},{"id":"2","name":"bob","port":9090}]}`,
+			expectedQuery: "fields=id,name,port",
+			expected: []*types.AccessKey{
+				{ID: "1", Name: "alice", Port: 8080},
+				{ID: "2", Name: "bob", Port: 9090},
+			},
+		},
+		{
+			name:          "server ignores fields",
+			fields:        []string{"id"},
+			body:          `{"accessKeys":[{"id":"1","name":"alice","password":"p","port":8080,"method":"aes-128-gcm","accessUrl":"ss://x"}]}`,
+			expectedQuery: "fields=id",
+			expected: []*types.AccessKey{
+				{ID: "1", Name: "alice", Password: "p", Port: 8080, Method: "aes-128-gcm", AccessURL: "ss://x"},
+			},
+		},
+		{
+			name:          "no fields",
+			fields:        nil,
+			body:          `{"accessKeys":[]}`,
+			expectedQuery: "",
+			expected:      []*types.AccessKey{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var capturedReq *contracts.Request
+			mockDoer := newMockDoerAccessKey(t, &contracts.Response{
+				StatusCode: http.StatusOK,
+				Body:       []byte(tt.body),
+			}, nil, &capturedReq)
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			result, err := client.GetAccessKeysFields(context.Background(), tt.fields)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			u, err := url.Parse(capturedReq.URL)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedQuery, u.RawQuery)
+			assert.Equal(t, http.MethodGet, capturedReq.Method)
+		})
+	}
+}
+
+func TestGetAccessKeysFields_DoerError(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, nil, errors.New("network error"), nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.GetAccessKeysFields(context.Background(), []string{"id"})
+
+	// Assert
+	assert.Nil(t, result)
+	var doErr *DoError
+	require.ErrorAs(t, err, &doErr)
+	assert.Equal(t, "get access keys fields", doErr.operation)
+}
+
+// === UpdateAccessKeyPort Tests ===
+
+func TestUpdateAccessKeyPort_Success(t *testing.T) {
+	// Arrange
+	current := types.AccessKey{
+		ID: "1", Name: "alice", Password: "secret", Port: 8080, Method: types.MethodAES128GCM,
+		Limit: &types.Limit{Bytes: 1000},
+	}
+	var (
+		calls []string
+		sent  types.CreateAccessKey
+	)
+	mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+		switch req.Method {
+		case http.MethodGet:
+			return jsonResponse(http.StatusOK, current), nil
+		case http.MethodDelete:
+			return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+		}
+		require.NoError(t, json.Unmarshal(req.Body, &sent))
+		recreated := current
+		recreated.Port = int(sent.Port)
+		return jsonResponse(http.StatusCreated, recreated), nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	updated, err := client.UpdateAccessKeyPort(context.Background(), "1", 9090)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"GET /access-keys/1", "DELETE /access-keys/1", "PUT /access-keys/1"}, calls)
+	assert.Equal(t, types.CreateAccessKey{
+		Method: types.MethodAES128GCM, Name: "alice", Password: "secret", Port: 9090, Limit: &types.Limit{Bytes: 1000},
+	}, sent)
+	assert.Equal(t, "1", updated.ID)
+	assert.Equal(t, 9090, updated.Port)
+}
+
+func TestUpdateAccessKeyPort_RecreateFails(t *testing.T) {
+	tests := []struct {
+		name          string
+		restoreStatus int
+		expectedErrs  []error
+	}{
+		{
+			name:          "previous key restored",
+			restoreStatus: http.StatusCreated,
+			expectedErrs:  []error{UnexpectedStatusCodeError},
+		},
+		{
+			name:          "restoration fails",
+			restoreStatus: http.StatusConflict,
+			expectedErrs:  []error{UnexpectedStatusCodeError, KeyAlreadyExistsError},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			current := types.AccessKey{ID: "1", Password: "secret", Port: 8080, Method: types.MethodAES128GCM}
+			var (
+				calls []string
+				ports []uint16
+			)
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				switch req.Method {
+				case http.MethodGet:
+					return jsonResponse(http.StatusOK, current), nil
+				case http.MethodDelete:
+					return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+				}
+				var sent types.CreateAccessKey
+				require.NoError(t, json.Unmarshal(req.Body, &sent))
+				ports = append(ports, sent.Port)
+				if sent.Port == 443 {
+					return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+				}
+				return jsonResponse(tt.restoreStatus, current), nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			updated, err := client.UpdateAccessKeyPort(context.Background(), "1", 443)
+
+			// Assert
+			assert.Nil(t, updated)
+			for _, expectedErr := range tt.expectedErrs {
+				assert.ErrorIs(t, err, expectedErr)
+			}
+			assert.Equal(t, []uint16{443, 8080}, ports)
+			assert.Equal(t, []string{
+				"GET /access-keys/1", "DELETE /access-keys/1", "PUT /access-keys/1", "PUT /access-keys/1",
+			}, calls)
+		})
+	}
+}
+
+func TestUpdateAccessKeyPort_DeleteFails(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+		if req.Method == http.MethodGet {
+			return jsonResponse(http.StatusOK, types.AccessKey{ID: "1", Port: 8080}), nil
+		}
+		return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	updated, err := client.UpdateAccessKeyPort(context.Background(), "1", 443)
+
+	// Assert
+	assert.Nil(t, updated)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+	assert.Equal(t, []string{"GET /access-keys/1", "DELETE /access-keys/1"}, calls)
+}
+
+func TestUpdateAccessKeyPort_ZeroPort(t *testing.T) {
+	// Arrange
+	mockDoer := NewMockDoer(t)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	updated, err := client.UpdateAccessKeyPort(context.Background(), "1", 0)
+
+	// Assert
+	assert.Nil(t, updated)
+	var argErr *ArgumentError
+	require.ErrorAs(t, err, &argErr)
+	assert.ErrorIs(t, err, InvalidArgumentError)
+	assert.ErrorIs(t, err, InvalidPortError)
+	mockDoer.AssertNotCalled(t, "Do")
+}
+
+func TestUpdateAccessKey_Conflict(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{StatusCode: http.StatusConflict}, nil, nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	updated, err := client.UpdateAccessKey(context.Background(), "1", &types.AccessKey{ID: "1", Port: 443})
+
+	// Assert
+	assert.Nil(t, updated)
+	var clientErr *ClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, http.StatusConflict, clientErr.StatusCode())
+	assert.Equal(t, "update access key", clientErr.Operation())
+	assert.ErrorIs(t, err, KeyAlreadyExistsError)
+	assert.NotErrorIs(t, err, PortAlreadyInUseError)
+}
+
+func TestGetAccessKeys_NumericIDs(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{
+			StatusCode: http.StatusOK,
+			Body:       []byte(`{"accessKeys":[{"id":0,"name":"alice"},{"id":"1","name":"bob"}]}`),
+		}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	keys, err := client.GetAccessKeys(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "0", keys[0].ID)
+	assert.Equal(t, "1", keys[1].ID)
+}
+
+// === RotateAccessKeyPassword Tests ===
+
+func TestRotateAccessKeyPassword(t *testing.T) {
+	tests := []struct {
+		name             string
+		password         string
+		expectedPassword string
+	}{
+		{name: "server-generated", password: "", expectedPassword: "generated"},
+		{name: "caller-chosen", password: "chosen", expectedPassword: "chosen"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			current := types.AccessKey{
+				ID: "1", Name: "alice", Password: "old", Port: 8080, Method: types.MethodAES128GCM,
+				AccessURL: "ss://old@example.com:8080",
+			}
+			var (
+				calls []string
+				sent  types.CreateAccessKey
+			)
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				switch req.Method {
+				case http.MethodGet:
+					return jsonResponse(http.StatusOK, current), nil
+				case http.MethodDelete:
+					return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+				}
+				require.NoError(t, json.Unmarshal(req.Body, &sent))
+				rotated := current
+				rotated.Password = cmp.Or(sent.Password, "generated")
+				rotated.AccessURL = "ss://" + rotated.Password + "@example.com:8080"
+				return jsonResponse(http.StatusCreated, rotated), nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			updated, err := client.RotateAccessKeyPassword(context.Background(), "1", tt.password)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []string{"GET /access-keys/1", "DELETE /access-keys/1", "PUT /access-keys/1"}, calls)
+			assert.Equal(t, types.CreateAccessKey{
+				Method: types.MethodAES128GCM, Name: "alice", Password: tt.password, Port: 8080,
+			}, sent)
+			assert.Equal(t, tt.expectedPassword, updated.Password)
+			assert.Equal(t, "ss://"+tt.expectedPassword+"@example.com:8080", updated.AccessURL)
+		})
+	}
+}
+
+func TestRotateAccessKeyPassword_NotFound(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithNotFoundAsNil())
+
+	// Act
+	updated, err := client.RotateAccessKeyPassword(context.Background(), "missing", "")
+
+	// Assert
+	assert.Nil(t, updated)
+	assert.ErrorIs(t, err, AccessKeyNotFoundError)
+	assert.Equal(t, []string{"GET /access-keys/missing"}, calls)
+}
+
+// === GetAccessKeysParsed Tests ===
+
+func TestGetAccessKeysParsed(t *testing.T) {
+	// Arrange
+	keys := []*types.AccessKey{
+		{ID: "1", AccessURL: "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@203.0.113.1:8388/?outline=1#Alice"},
+		{ID: "2", AccessURL: "https://203.0.113.1:8388"},
+		{ID: "3", AccessURL: "ss://not*base64@203.0.113.1:8388"},
+		{ID: "4", AccessURL: ""},
+	}
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return jsonResponse(http.StatusOK, map[string]any{"accessKeys": keys}), nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	parsed, err := client.GetAccessKeysParsed(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, parsed, 4)
+
+	assert.Equal(t, "1", parsed[0].Key.ID)
+	require.NoError(t, parsed[0].Err)
+	assert.Equal(t, &types.ShadowsocksConfig{
+		Method: "chacha20-ietf-poly1305", Password: "pass", Host: "203.0.113.1", Port: 8388, Tag: "Alice",
+	}, parsed[0].Config)
+
+	for i, expectedMsg := range []string{"does not start with ss://", "user info is not base64", "does not start with ss://"} {
+		key := parsed[i+1]
+		assert.Equal(t, keys[i+1].ID, key.Key.ID)
+		assert.Nil(t, key.Config)
+		assert.ErrorIs(t, key.Err, types.InvalidAccessURLError)
+		assert.ErrorContains(t, key.Err, expectedMsg)
+	}
+}
+
+func TestGetAccessKeysParsed_Error(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{StatusCode: http.StatusInternalServerError}, nil, nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	parsed, err := client.GetAccessKeysParsed(context.Background())
+
+	// Assert
+	assert.Nil(t, parsed)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+}
@@ -0,0 +1,70 @@
+package outline
+
+import (
+	"context"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// withAccessURLHost rewrites the access URL of the decoded key as configured by [WithAccessURLHostRewrite].
+// A decoding error is returned unchanged.
+func (c *Client) withAccessURLHost(ctx context.Context, key *types.AccessKey, err error) (*types.AccessKey, error) {
+	if err != nil {
+		return nil, err
+	}
+	c.rewriteAccessURLs(ctx, []*types.AccessKey{key})
+	return key, nil
+}
+
+// withAccessURLHosts rewrites the access URLs of the decoded keys as configured by [WithAccessURLHostRewrite].
+// A decoding error is returned unchanged.
+func (c *Client) withAccessURLHosts(ctx context.Context, keys []*types.AccessKey, err error) ([]*types.AccessKey, error) {
+	if err != nil {
+		return nil, err
+	}
+	c.rewriteAccessURLs(ctx, keys)
+	return keys, nil
+}
+
+// rewriteAccessURLs replaces the host of every access URL with the server HostnameForAccessKeys
+// when [WithAccessURLHostRewrite] is set. Empty or invalid access URLs are left untouched,
+// as are all URLs if the server reports no hostname or the hostname cannot be read:
+// the keys, possibly just created, are still returned to the caller.
+func (c *Client) rewriteAccessURLs(ctx context.Context, keys []*types.AccessKey) {
+	if !c.accessURLHostRewrite || len(keys) == 0 {
+		return
+	}
+
+	hostname, err := c.hostnameForAccessKeys(ctx)
+	if err != nil {
+		c.logger.Infof(ctx, "access URL host not rewritten: %v", err)
+		return
+	}
+	if hostname == "" {
+		return
+	}
+
+	for _, key := range keys {
+		if key == nil || key.AccessURL == "" {
+			continue
+		}
+		if rewritten, err := key.AccessURLWithHost(hostname); err == nil {
+			key.AccessURL = rewritten
+		}
+	}
+}
+
+// hostnameForAccessKeys returns the server HostnameForAccessKeys, reading it through
+// [Client.GetServerInfo] on first use. The hostname is kept until a call changes the server configuration.
+func (c *Client) hostnameForAccessKeys(ctx context.Context) (string, error) {
+	if hostname := c.accessURLHost.Load(); hostname != nil {
+		return *hostname, nil
+	}
+
+	info, err := c.GetServerInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.accessURLHost.Store(&info.HostnameForAccessKeys)
+	return info.HostnameForAccessKeys, nil
+}
@@ -0,0 +1,152 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithAccessURLHostRewrite Tests ===
+
+func TestWithAccessURLHostRewrite_GetAccessKeys(t *testing.T) {
+	const internalURL = "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@10.0.0.5:8388/?outline=1#Laptop"
+
+	tests := []struct {
+		name          string
+		options       []Option
+		hostname      string
+		expectedURLs  []string
+		expectedCalls []string
+	}{
+		{
+			name:          "enabled",
+			options:       []Option{WithAccessURLHostRewrite()},
+			hostname:      "vpn.example.com",
+			expectedURLs:  []string{"ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@vpn.example.com:8388/?outline=1#Laptop", "", "not a url"},
+			expectedCalls: []string{"GET /access-keys", "GET /server"},
+		},
+		{
+			name:          "enabled without server hostname",
+			options:       []Option{WithAccessURLHostRewrite()},
+			expectedURLs:  []string{internalURL, "", "not a url"},
+			expectedCalls: []string{"GET /access-keys", "GET /server"},
+		},
+		{
+			name:          "disabled",
+			hostname:      "vpn.example.com",
+			expectedURLs:  []string{internalURL, "", "not a url"},
+			expectedCalls: []string{"GET /access-keys"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				if requestPath(req) == "/server" {
+					return jsonResponse(http.StatusOK, types.ServerInfoResponse{HostnameForAccessKeys: tt.hostname}), nil
+				}
+				return jsonResponse(http.StatusOK, map[string]any{"accessKeys": []*types.AccessKey{
+					{ID: "1", AccessURL: internalURL},
+					{ID: "2"},
+					{ID: "3", AccessURL: "not a url"},
+				}}), nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+
+			// Act
+			keys, err := client.GetAccessKeys(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			urls := make([]string, 0, len(keys))
+			for _, key := range keys {
+				urls = append(urls, key.AccessURL)
+			}
+			assert.Equal(t, tt.expectedURLs, urls)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestWithAccessURLHostRewrite_CachedServerInfo(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+		return jsonResponse(http.StatusOK, types.AccessKey{ID: "1", AccessURL: "ss://dXNlcjpwYXNz@10.0.0.5:8388"}), nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithAccessURLHostRewrite(),
+		WithInitialServerInfo(&types.ServerInfoResponse{HostnameForAccessKeys: "vpn.example.com"}))
+
+	// Act
+	key, err := client.GetAccessKey(context.Background(), "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "ss://dXNlcjpwYXNz@vpn.example.com:8388", key.AccessURL)
+	assert.Equal(t, []string{"GET /access-keys/1"}, calls)
+}
+
+func TestWithAccessURLHostRewrite_ServerInfoError(t *testing.T) {
+	// Arrange
+	const accessURL = "ss://dXNlcjpwYXNz@10.0.0.5:8388"
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		if requestPath(req) == "/server" {
+			return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+		}
+		return jsonResponse(http.StatusCreated, types.AccessKey{ID: "1", AccessURL: accessURL}), nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithAccessURLHostRewrite())
+
+	// Act
+	key, err := client.CreateAccessKey(context.Background(), &types.CreateAccessKey{Method: "aes-128-gcm"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "1", key.ID)
+	assert.Equal(t, accessURL, key.AccessURL)
+}
+
+func TestWithAccessURLHostRewrite_CachesHostname(t *testing.T) {
+	// Arrange
+	var calls []string
+	hostname := "vpn.example.com"
+	mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+		switch requestPath(req) {
+		case "/server":
+			return jsonResponse(http.StatusOK, types.ServerInfoResponse{HostnameForAccessKeys: hostname}), nil
+		case "/server/hostname-for-access-keys":
+			hostname = "new.example.com"
+			return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+		}
+		return jsonResponse(http.StatusOK, types.AccessKey{ID: "1", AccessURL: "ss://dXNlcjpwYXNz@10.0.0.5:8388"}), nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithAccessURLHostRewrite())
+	ctx := context.Background()
+
+	// Act
+	first, firstErr := client.GetAccessKey(ctx, "1")
+	second, secondErr := client.GetAccessKey(ctx, "1")
+	updateErr := client.UpdateServerHostname(ctx, "new.example.com")
+	third, thirdErr := client.GetAccessKey(ctx, "1")
+
+	// Assert
+	require.NoError(t, firstErr)
+	require.NoError(t, secondErr)
+	require.NoError(t, updateErr)
+	require.NoError(t, thirdErr)
+	assert.Equal(t, "ss://dXNlcjpwYXNz@vpn.example.com:8388", first.AccessURL)
+	assert.Equal(t, first.AccessURL, second.AccessURL)
+	assert.Equal(t, "ss://dXNlcjpwYXNz@new.example.com:8388", third.AccessURL)
+	assert.Equal(t, []string{
+		"GET /access-keys/1", "GET /server", "GET /access-keys/1",
+		"PUT /server/hostname-for-access-keys",
+		"GET /access-keys/1", "GET /server",
+	}, calls)
+}
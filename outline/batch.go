@@ -0,0 +1,324 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// === Batch Operations for Access Keys ===
+
+// BatchCreateAccessKeys creates an access key for every request in reqs,
+// running at most concurrency requests at the same time.
+// A concurrency below 1 creates the keys sequentially.
+//
+// The returned slices always have the same length as reqs and are index-aligned with it:
+// keys[i] and errs[i] are the outcome of reqs[i], regardless of completion order.
+//
+// By default a failure of one request does not stop the others.
+// With [WithBatchRollback] the first failure cancels the remaining requests
+// and the keys created so far are deleted; see [WithBatchRollback] for details.
+func (c *Client) BatchCreateAccessKeys(
+	ctx context.Context, reqs []*types.CreateAccessKey, concurrency int,
+) ([]*types.AccessKey, []error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	keys := make([]*types.AccessKey, len(reqs))
+	errs := make([]error, len(reqs))
+
+	batchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		failOnce sync.Once
+		firstErr error
+	)
+	runConcurrently(len(reqs), concurrency, func(i int) {
+		keys[i], errs[i] = c.CreateAccessKey(batchCtx, reqs[i])
+		if errs[i] != nil && c.batchRollback {
+			failOnce.Do(func() {
+				firstErr = errs[i]
+				cancel()
+			})
+		}
+	})
+
+	if firstErr != nil {
+		c.rollbackCreatedKeys(ctx, keys, errs, firstErr)
+	}
+
+	return keys, errs
+}
+
+// rollbackCreatedKeys deletes every created key of a failed batch and replaces
+// its result with a [*RollbackError] wrapping cause and the deletion error, if any.
+// Deletion is best effort: a failed deletion is reported but does not stop the rollback.
+func (c *Client) rollbackCreatedKeys(ctx context.Context, keys []*types.AccessKey, errs []error, cause error) {
+	for i, key := range keys {
+		if key == nil {
+			continue
+		}
+		deleteErr := c.DeleteAccessKey(ctx, key.ID)
+		keys[i] = nil
+		errs[i] = errRolledBack(key.ID, cause, deleteErr)
+	}
+}
+
+// DeleteAccessKeys deletes the access keys with the given IDs one after another.
+// A failure, including [AccessKeyNotFoundError] for a missing key, is recorded in the result
+// and does not stop the batch.
+//
+// The result maps every attempted ID to nil or its error and counts the successful deletions.
+// If ctx is done between two deletions, the batch stops early: the remaining IDs have no entry
+// in the result and the context error is returned along with the partial result.
+func (c *Client) DeleteAccessKeys(ctx context.Context, ids []string) (*types.BulkResult, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	result := &types.BulkResult{Results: make(map[string]error, len(ids))}
+	for _, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		err := c.DeleteAccessKey(ctx, id)
+		result.Results[id] = err
+		if err == nil {
+			result.Succeeded++
+		}
+	}
+
+	return result, nil
+}
+
+// RenameAccessKeys renames every access key in renames, mapping an access key ID to its new name,
+// running at most concurrency requests at the same time.
+// A concurrency below 1 renames the keys sequentially.
+//
+// The returned map holds an entry only for the IDs whose rename failed, so an empty map
+// means every key was renamed. A failure of one rename does not stop the others.
+// Once ctx is done, the renames that have not started yet fail with the context error.
+func (c *Client) RenameAccessKeys(ctx context.Context, renames map[string]string, concurrency int) map[string]error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	ids := slices.Sorted(maps.Keys(renames))
+
+	var mu sync.Mutex
+	errs := make(map[string]error)
+	runConcurrently(len(ids), concurrency, func(i int) {
+		id := ids[i]
+		if err := c.UpdateNameAccessKey(ctx, id, renames[id]); err != nil {
+			mu.Lock()
+			errs[id] = err
+			mu.Unlock()
+		}
+	})
+
+	return errs
+}
+
+// ApplyNamingConvention sets the server name to serverName and renames every access key
+// to the name returned by keyNameFunc, running at most concurrency renames at the same time.
+// An empty serverName leaves the server name untouched. The server and keys that already have
+// the desired name are not renamed. A concurrency below 1 renames the keys sequentially.
+//
+// A failed key rename does not stop the others and is reported in [types.RenameReport.Failed];
+// once ctx is done, the renames that have not started yet fail with the context error.
+// The returned error is reserved for failures that prevent the renaming of keys:
+// it returns [*ArgumentError] wrapping [InvalidArgumentError] if keyNameFunc is nil,
+// and the errors of [Client.GetServerInfo], [Client.UpdateServerName] and [Client.GetAccessKeys].
+func (c *Client) ApplyNamingConvention(
+	ctx context.Context, serverName string, keyNameFunc func(*types.AccessKey) string, concurrency int,
+) (types.RenameReport, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	var report types.RenameReport
+	if keyNameFunc == nil {
+		return report, errInvalidArgument("keyNameFunc", errors.New("key name function is nil"))
+	}
+
+	if serverName != "" {
+		info, err := c.GetServerInfo(ctx)
+		if err != nil {
+			return report, err
+		}
+		if info.Name != serverName {
+			if err := c.UpdateServerName(ctx, serverName); err != nil {
+				return report, err
+			}
+			report.ServerRenamed = true
+		}
+	}
+
+	keys, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return report, err
+	}
+
+	renames := make(map[string]string, len(keys))
+	for _, key := range keys {
+		if name := keyNameFunc(key); name != key.Name {
+			renames[key.ID] = name
+			continue
+		}
+		report.Unchanged = append(report.Unchanged, key.ID)
+	}
+
+	report.Failed = c.RenameAccessKeys(ctx, renames, concurrency)
+	for _, key := range keys {
+		if _, ok := renames[key.ID]; !ok {
+			continue
+		}
+		if _, failed := report.Failed[key.ID]; !failed {
+			report.Renamed = append(report.Renamed, key.ID)
+		}
+	}
+
+	return report, nil
+}
+
+// PinServerDefaultLimitToAllKeys copies the server-wide data limit to every access key
+// that has no explicit limit of its own, so the keys keep their limit when the
+// server-wide default is changed or removed later. Keys that already have a limit are skipped.
+// At most concurrency keys are updated at the same time; a concurrency below 1 updates them sequentially.
+//
+// It returns the number of keys that were pinned and the errors of the failed updates by access key ID.
+// If no server-wide limit is set there is nothing to pin and it returns zero and no errors.
+// A failure to read the server information or the access keys is reported under the empty ID.
+func (c *Client) PinServerDefaultLimitToAllKeys(ctx context.Context, concurrency int) (int, map[string]error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	info, err := c.GetServerInfo(ctx)
+	if err != nil {
+		return 0, map[string]error{"": err}
+	}
+	if info.AccessKeyDataLimit == nil {
+		return 0, nil
+	}
+
+	keys, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return 0, map[string]error{"": err}
+	}
+
+	var ids []string
+	for _, key := range keys {
+		if key.Limit == nil {
+			ids = append(ids, key.ID)
+		}
+	}
+
+	var (
+		mu     sync.Mutex
+		pinned int
+		errs   = make(map[string]error)
+	)
+	bytes := info.AccessKeyDataLimit.Bytes
+	runConcurrently(len(ids), concurrency, func(i int) {
+		err := c.UpdateDataLimitAccessKey(ctx, ids[i], bytes)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[ids[i]] = err
+			return
+		}
+		pinned++
+	})
+
+	return pinned, errs
+}
+
+// RotateAllPasswords has the server generate a new password for every access key
+// with [Client.RotateAccessKeyPassword], running at most concurrency rotations at the same time.
+// A concurrency below 1 rotates the passwords sequentially.
+//
+// Rotation disconnects every client: users must download or import the new access URLs
+// before they can connect again.
+// Each rotation deletes and recreates its key, so it is not atomic; see [Client.RotateAccessKeyPassword].
+//
+// It returns the new access URLs of the rotated keys and the errors of the failed rotations,
+// both by access key ID. A failure of one key does not stop the others; once ctx is done,
+// the rotations that have not started yet fail with the context error. A failure to list
+// the access keys is reported under the empty ID.
+func (c *Client) RotateAllPasswords(ctx context.Context, concurrency int) (accessURLs map[string]string, errs map[string]error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	keys, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return map[string]string{}, map[string]error{"": err}
+	}
+
+	var mu sync.Mutex
+	accessURLs = make(map[string]string, len(keys))
+	errs = make(map[string]error)
+	runConcurrently(len(keys), concurrency, func(i int) {
+		id := keys[i].ID
+		key, err := c.RotateAccessKeyPassword(ctx, id, "")
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[id] = err
+			return
+		}
+		accessURLs[id] = key.AccessURL
+	})
+
+	return accessURLs, errs
+}
+
+// ApplyDataLimits sets the data limit of every access key in limits, mapping an access key ID
+// to its limit in bytes, running at most concurrency requests at the same time.
+// A concurrency below 1 applies the limits sequentially.
+//
+// A zero limit is rejected with [*ArgumentError] wrapping [InvalidArgumentError], since it would
+// block all traffic of the key; with [WithZeroDataLimitAsUnlimited] it removes the key's limit instead.
+//
+// It returns the number of limits applied and an entry in errs for every ID that failed,
+// e.g. [AccessKeyNotFoundError] for a missing key. A failure of one key does not stop the others;
+// once ctx is done, the keys that have not started yet fail with the context error.
+func (c *Client) ApplyDataLimits(
+	ctx context.Context, limits map[string]uint64, concurrency int,
+) (applied int, errs map[string]error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	ids := slices.Sorted(maps.Keys(limits))
+
+	var mu sync.Mutex
+	errs = make(map[string]error)
+	runConcurrently(len(ids), concurrency, func(i int) {
+		id := ids[i]
+		err := c.applyDataLimit(ctx, id, limits[id])
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			errs[id] = err
+			return
+		}
+		applied++
+	})
+
+	return applied, errs
+}
+
+// applyDataLimit sets the data limit of a single key for [Client.ApplyDataLimits].
+func (c *Client) applyDataLimit(ctx context.Context, id string, bytes uint64) error {
+	if bytes > 0 {
+		return c.UpdateDataLimitAccessKey(ctx, id, bytes)
+	}
+	if !c.zeroLimitAsUnlimited {
+		return errInvalidArgument("limits", fmt.Errorf("data limit of access key %q is zero", id))
+	}
+	return c.DeleteDataLimitAccessKey(ctx, id)
+}
@@ -0,0 +1,652 @@
+package outline
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === BatchCreateAccessKeys Tests ===
+
+func TestBatchCreateAccessKeys_PreservesOrder(t *testing.T) {
+	// Arrange
+	const n = 8
+	reqs := make([]*types.CreateAccessKey, n)
+	finished := make([]chan struct{}, n)
+	for i := range n {
+		reqs[i] = &types.CreateAccessKey{Name: fmt.Sprintf("key-%d", i)}
+		finished[i] = make(chan struct{})
+	}
+
+	// Every request waits for the next one to finish, so they complete in reverse order.
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		var create types.CreateAccessKey
+		require.NoError(t, json.Unmarshal(req.Body, &create))
+		var i int
+		_, _ = fmt.Sscanf(create.Name, "key-%d", &i)
+		if i+1 < n {
+			<-finished[i+1]
+		}
+		defer close(finished[i])
+		return jsonResponse(http.StatusCreated, types.AccessKey{ID: fmt.Sprint(i), Name: create.Name}), nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	keys, errs := client.BatchCreateAccessKeys(context.Background(), reqs, n)
+
+	// Assert
+	require.Len(t, keys, n)
+	require.Len(t, errs, n)
+	for i := range n {
+		assert.NoError(t, errs[i])
+		require.NotNil(t, keys[i])
+		assert.Equal(t, reqs[i].Name, keys[i].Name)
+		assert.Equal(t, fmt.Sprint(i), keys[i].ID)
+	}
+}
+
+func TestBatchCreateAccessKeys_PartialFailure(t *testing.T) {
+	// Arrange
+	reqs := []*types.CreateAccessKey{{Name: "ok-1"}, {Name: "fail"}, {Name: "ok-2"}}
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		var create types.CreateAccessKey
+		require.NoError(t, json.Unmarshal(req.Body, &create))
+		if create.Name == "fail" {
+			return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+		}
+		return jsonResponse(http.StatusCreated, types.AccessKey{ID: create.Name, Name: create.Name}), nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	keys, errs := client.BatchCreateAccessKeys(context.Background(), reqs, 2)
+
+	// Assert
+	require.Len(t, keys, 3)
+	require.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	assert.Equal(t, "ok-1", keys[0].ID)
+	assert.ErrorIs(t, errs[1], UnexpectedStatusCodeError)
+	assert.Nil(t, keys[1])
+	assert.NoError(t, errs[2])
+	assert.Equal(t, "ok-2", keys[2].ID)
+}
+
+func TestBatchCreateAccessKeys_Empty(t *testing.T) {
+	// Arrange
+	client := createTestClientForAccessKeys(NewMockDoer(t))
+
+	// Act
+	keys, errs := client.BatchCreateAccessKeys(context.Background(), nil, 0)
+
+	// Assert
+	assert.Empty(t, keys)
+	assert.Empty(t, errs)
+}
+
+func TestBatchCreateAccessKeys_Rollback(t *testing.T) {
+	tests := []struct {
+		name               string
+		options            []Option
+		deleteStatus       int
+		expectedCalls      []string
+		expectRolledBack   bool
+		expectRollbackFail bool
+	}{
+		{
+			name:          "partial success without rollback",
+			expectedCalls: []string{"POST /access-keys", "POST /access-keys"},
+		},
+		{
+			name:             "first key rolled back",
+			options:          []Option{WithBatchRollback()},
+			deleteStatus:     http.StatusNoContent,
+			expectedCalls:    []string{"POST /access-keys", "POST /access-keys", "DELETE /access-keys/first"},
+			expectRolledBack: true,
+		},
+		{
+			name:               "rollback deletion fails",
+			options:            []Option{WithBatchRollback()},
+			deleteStatus:       http.StatusInternalServerError,
+			expectedCalls:      []string{"POST /access-keys", "POST /access-keys", "DELETE /access-keys/first"},
+			expectRolledBack:   true,
+			expectRollbackFail: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				if req.Method == http.MethodDelete {
+					return &contracts.Response{StatusCode: tt.deleteStatus}, nil
+				}
+				var create types.CreateAccessKey
+				require.NoError(t, json.Unmarshal(req.Body, &create))
+				if create.Name == "second" {
+					return &contracts.Response{StatusCode: http.StatusBadRequest}, nil
+				}
+				return jsonResponse(http.StatusCreated, types.AccessKey{ID: create.Name, Name: create.Name}), nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+			reqs := []*types.CreateAccessKey{{Name: "first"}, {Name: "second"}}
+
+			// Act
+			keys, errs := client.BatchCreateAccessKeys(context.Background(), reqs, 1)
+
+			// Assert
+			assert.Equal(t, tt.expectedCalls, calls)
+			require.Len(t, errs, 2)
+			assert.ErrorIs(t, errs[1], UnexpectedStatusCodeError)
+			assert.Nil(t, keys[1])
+
+			if !tt.expectRolledBack {
+				assert.NoError(t, errs[0])
+				require.NotNil(t, keys[0])
+				assert.Equal(t, "first", keys[0].ID)
+				return
+			}
+
+			assert.Nil(t, keys[0])
+			var rollbackErr *RollbackError
+			require.ErrorAs(t, errs[0], &rollbackErr)
+			assert.Equal(t, "first", rollbackErr.accessKeyID)
+			assert.ErrorIs(t, errs[0], BatchRolledBackError)
+			assert.ErrorIs(t, errs[0], UnexpectedStatusCodeError)
+			if tt.expectRollbackFail {
+				assert.ErrorIs(t, errs[0], RollbackFailedError)
+			} else {
+				assert.NotErrorIs(t, errs[0], RollbackFailedError)
+			}
+		})
+	}
+}
+
+// === DeleteAccessKeys Tests ===
+
+func TestDeleteAccessKeys_MixedResults(t *testing.T) {
+	// Arrange
+	errNetwork := errors.New("connection reset")
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+		switch requestPath(req) {
+		case "/access-keys/missing":
+			return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+		case "/access-keys/unreachable":
+			return nil, errNetwork
+		}
+		return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.DeleteAccessKeys(context.Background(), []string{"1", "missing", "unreachable", "2"})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Succeeded)
+	require.Len(t, result.Results, 4)
+	assert.NoError(t, result.Results["1"])
+	assert.NoError(t, result.Results["2"])
+	assert.ErrorIs(t, result.Results["missing"], AccessKeyNotFoundError)
+	assert.ErrorIs(t, result.Results["unreachable"], errNetwork)
+	assert.ErrorIs(t, result.Results["unreachable"], DoOperationError)
+	assert.Equal(t, []string{
+		"DELETE /access-keys/1",
+		"DELETE /access-keys/missing",
+		"DELETE /access-keys/unreachable",
+		"DELETE /access-keys/2",
+	}, calls)
+}
+
+func TestDeleteAccessKeys_StopsWhenContextDone(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+		cancel()
+		return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.DeleteAccessKeys(ctx, []string{"1", "2", "3"})
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"DELETE /access-keys/1"}, calls)
+	require.NotNil(t, result)
+	assert.Len(t, result.Results, 1)
+	assert.Contains(t, result.Results, "1")
+}
+
+func TestDeleteAccessKeys_Empty(t *testing.T) {
+	// Arrange
+	client := createTestClientForAccessKeys(NewMockDoer(t))
+
+	// Act
+	result, err := client.DeleteAccessKeys(context.Background(), nil)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Empty(t, result.Results)
+	assert.Zero(t, result.Succeeded)
+}
+
+// === RotateAllPasswords Tests ===
+
+func TestRotateAllPasswords(t *testing.T) {
+	// Arrange
+	keys := []*types.AccessKey{{ID: "1", Password: "a"}, {ID: "2", Password: "b"}, {ID: "3", Password: "c"}}
+	var (
+		mu        sync.Mutex
+		passwords = make(map[string][]string)
+	)
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		path := requestPath(req)
+		id := strings.TrimPrefix(path, "/access-keys/")
+		switch {
+		case path == "/access-keys":
+			return jsonResponse(http.StatusOK, map[string]any{"accessKeys": keys}), nil
+		case req.Method == http.MethodGet:
+			return jsonResponse(http.StatusOK, types.AccessKey{ID: id, Password: "old"}), nil
+		case req.Method == http.MethodDelete:
+			return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+		}
+		var sent types.CreateAccessKey
+		require.NoError(t, json.Unmarshal(req.Body, &sent))
+		mu.Lock()
+		passwords[id] = append(passwords[id], sent.Password)
+		mu.Unlock()
+		if id == "2" && sent.Password == "" {
+			return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+		}
+		password := cmp.Or(sent.Password, "new")
+		return jsonResponse(http.StatusCreated, types.AccessKey{
+			ID: id, Password: password, AccessURL: "ss://" + password + "@vpn.example.com:" + id,
+		}), nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	accessURLs, errs := client.RotateAllPasswords(context.Background(), 2)
+
+	// Assert
+	assert.Equal(t, map[string]string{"1": "ss://new@vpn.example.com:1", "3": "ss://new@vpn.example.com:3"}, accessURLs)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs["2"], UnexpectedStatusCodeError)
+	assert.Equal(t, map[string][]string{"1": {""}, "2": {"", "old"}, "3": {""}}, passwords)
+}
+
+func TestRotateAllPasswords_ListError(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	accessURLs, errs := client.RotateAllPasswords(context.Background(), 2)
+
+	// Assert
+	assert.Empty(t, accessURLs)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[""], UnexpectedStatusCodeError)
+}
+
+func TestRotateAllPasswords_CancelledContext(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		cancel()
+		return jsonResponse(http.StatusOK, map[string]any{"accessKeys": []*types.AccessKey{{ID: "1"}, {ID: "2"}}}), nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	accessURLs, errs := client.RotateAllPasswords(ctx, 1)
+
+	// Assert
+	assert.Empty(t, accessURLs)
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		assert.ErrorIs(t, err, context.Canceled)
+	}
+	assert.Equal(t, []string{"GET /access-keys"}, calls)
+}
+
+// === RenameAccessKeys Tests ===
+
+func TestRenameAccessKeys_PartialFailure(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+		if requestPath(req) == "/access-keys/missing/name" {
+			return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+		}
+		return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+	renames := map[string]string{"1": "alice", "2": "bob", "missing": "carol", "3": "dave"}
+
+	// Act
+	errs := client.RenameAccessKeys(context.Background(), renames, 2)
+
+	// Assert
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs["missing"], AccessKeyNotFoundError)
+	assert.ElementsMatch(t, []string{
+		"PUT /access-keys/1/name",
+		"PUT /access-keys/2/name",
+		"PUT /access-keys/3/name",
+		"PUT /access-keys/missing/name",
+	}, calls)
+}
+
+func TestRenameAccessKeys_SendsNewName(t *testing.T) {
+	// Arrange
+	var (
+		mu    sync.Mutex
+		names = make(map[string]string)
+	)
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		var body struct {
+			Name string `json:"name"`
+		}
+		require.NoError(t, json.Unmarshal(req.Body, &body))
+		mu.Lock()
+		names[requestPath(req)] = body.Name
+		mu.Unlock()
+		return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	errs := client.RenameAccessKeys(context.Background(), map[string]string{"1": "alice", "2": "bob"}, 0)
+
+	// Assert
+	assert.Empty(t, errs)
+	assert.Equal(t, map[string]string{
+		"/access-keys/1/name": "alice",
+		"/access-keys/2/name": "bob",
+	}, names)
+}
+
+func TestRenameAccessKeys_CancelledContext(t *testing.T) {
+	// Arrange
+	mockDoer := NewMockDoer(t)
+	client := createTestClientForAccessKeys(mockDoer)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	errs := client.RenameAccessKeys(ctx, map[string]string{"1": "alice", "2": "bob"}, 2)
+
+	// Assert
+	require.Len(t, errs, 2)
+	for _, err := range errs {
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, err, DoOperationError)
+	}
+	mockDoer.AssertNotCalled(t, "Do")
+}
+
+// === PinServerDefaultLimitToAllKeys Tests ===
+
+func TestPinServerDefaultLimitToAllKeys(t *testing.T) {
+	// Arrange
+	keys := []*types.AccessKey{
+		{ID: "1"},
+		{ID: "2", Limit: &types.Limit{Bytes: 10}},
+		{ID: "3"},
+		{ID: "4"},
+	}
+	var (
+		mu      sync.Mutex
+		applied = make(map[string]uint64)
+	)
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		switch path := requestPath(req); {
+		case path == "/server":
+			return jsonResponse(http.StatusOK, types.ServerInfoResponse{AccessKeyDataLimit: &types.Limit{Bytes: 5000}}), nil
+		case path == "/access-keys":
+			return jsonResponse(http.StatusOK, map[string]any{"accessKeys": keys}), nil
+		case path == "/access-keys/4/data-limit":
+			return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+		default:
+			var body struct {
+				Limit types.Limit `json:"limit"`
+			}
+			require.NoError(t, json.Unmarshal(req.Body, &body))
+			mu.Lock()
+			applied[path] = body.Limit.Bytes
+			mu.Unlock()
+			return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+		}
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	pinned, errs := client.PinServerDefaultLimitToAllKeys(context.Background(), 2)
+
+	// Assert
+	assert.Equal(t, 2, pinned)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs["4"], AccessKeyNotFoundError)
+	assert.Equal(t, map[string]uint64{
+		"/access-keys/1/data-limit": 5000,
+		"/access-keys/3/data-limit": 5000,
+	}, applied)
+}
+
+func TestPinServerDefaultLimitToAllKeys_NoServerLimit(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		return jsonResponse(http.StatusOK, types.ServerInfoResponse{}), nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	pinned, errs := client.PinServerDefaultLimitToAllKeys(context.Background(), 2)
+
+	// Assert
+	assert.Zero(t, pinned)
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{"GET /server"}, calls)
+}
+
+func TestPinServerDefaultLimitToAllKeys_ReadError(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		if requestPath(req) == "/server" {
+			return jsonResponse(http.StatusOK, types.ServerInfoResponse{AccessKeyDataLimit: &types.Limit{Bytes: 1}}), nil
+		}
+		return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	pinned, errs := client.PinServerDefaultLimitToAllKeys(context.Background(), 2)
+
+	// Assert
+	assert.Zero(t, pinned)
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[""], UnexpectedStatusCodeError)
+}
+
+// === ApplyNamingConvention Tests ===
+
+func TestApplyNamingConvention(t *testing.T) {
+	tests := []struct {
+		name           string
+		currentName    string
+		serverName     string
+		expectedReport types.RenameReport
+		expectedCalls  []string
+	}{
+		{
+			name:        "server and keys renamed",
+			currentName: "Old",
+			serverName:  "New",
+			expectedReport: types.RenameReport{
+				ServerRenamed: true,
+				Renamed:       []string{"1"},
+				Unchanged:     []string{"2"},
+			},
+			expectedCalls: []string{"GET /server", "PUT /name", "GET /access-keys"},
+		},
+		{
+			name:           "server name unchanged",
+			currentName:    "New",
+			serverName:     "New",
+			expectedReport: types.RenameReport{Renamed: []string{"1"}, Unchanged: []string{"2"}},
+			expectedCalls:  []string{"GET /server", "GET /access-keys"},
+		},
+		{
+			name:           "server name left untouched",
+			currentName:    "Old",
+			expectedReport: types.RenameReport{Renamed: []string{"1"}, Unchanged: []string{"2"}},
+			expectedCalls:  []string{"GET /access-keys"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				switch req.Method + " " + requestPath(req) {
+				case "GET /server":
+					return jsonResponse(http.StatusOK, types.ServerInfoResponse{Name: tt.currentName}), nil
+				case "GET /access-keys":
+					return jsonResponse(http.StatusOK, map[string]any{"accessKeys": []*types.AccessKey{
+						{ID: "1", Name: "alice"},
+						{ID: "2", Name: "team-bob"},
+						{ID: "3", Name: "carol"},
+					}}), nil
+				case "PUT /access-keys/3/name":
+					return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+				}
+				return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+			keyNameFunc := func(key *types.AccessKey) string {
+				return "team-" + strings.TrimPrefix(key.Name, "team-")
+			}
+
+			// Act
+			report, err := client.ApplyNamingConvention(context.Background(), tt.serverName, keyNameFunc, 2)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, report.Failed, 1)
+			assert.ErrorIs(t, report.Failed["3"], UnexpectedStatusCodeError)
+			report.Failed = nil
+			assert.Equal(t, tt.expectedReport, report)
+			assert.Equal(t, tt.expectedCalls, calls[:len(tt.expectedCalls)])
+			assert.ElementsMatch(t, []string{"PUT /access-keys/1/name", "PUT /access-keys/3/name"}, calls[len(tt.expectedCalls):])
+		})
+	}
+}
+
+func TestApplyNamingConvention_NilKeyNameFunc(t *testing.T) {
+	// Arrange
+	client := createTestClientForAccessKeys(NewMockDoer(t))
+
+	// Act
+	_, err := client.ApplyNamingConvention(context.Background(), "New", nil, 1)
+
+	// Assert
+	var argErr *ArgumentError
+	require.ErrorAs(t, err, &argErr)
+	assert.ErrorIs(t, err, InvalidArgumentError)
+}
+
+// === ApplyDataLimits Tests ===
+
+func TestApplyDataLimits(t *testing.T) {
+	tests := []struct {
+		name            string
+		options         []Option
+		expectedApplied int
+		expectedCalls   []string
+		expectedErrs    map[string]error
+	}{
+		{
+			name:            "zero limit rejected",
+			expectedApplied: 1,
+			expectedCalls:   []string{"PUT /access-keys/1/data-limit", "PUT /access-keys/missing/data-limit"},
+			expectedErrs:    map[string]error{"2": InvalidArgumentError, "missing": AccessKeyNotFoundError},
+		},
+		{
+			name:            "zero limit as unlimited",
+			options:         []Option{WithZeroDataLimitAsUnlimited()},
+			expectedApplied: 2,
+			expectedCalls: []string{
+				"PUT /access-keys/1/data-limit", "DELETE /access-keys/2/data-limit", "PUT /access-keys/missing/data-limit",
+			},
+			expectedErrs: map[string]error{"missing": AccessKeyNotFoundError},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				if strings.Contains(requestPath(req), "/missing/") {
+					return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+				}
+				return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+			limits := map[string]uint64{"1": 1000, "2": 0, "missing": 2000}
+
+			// Act
+			applied, errs := client.ApplyDataLimits(context.Background(), limits, 2)
+
+			// Assert
+			assert.Equal(t, tt.expectedApplied, applied)
+			require.Len(t, errs, len(tt.expectedErrs))
+			for id, expected := range tt.expectedErrs {
+				assert.ErrorIs(t, errs[id], expected, id)
+			}
+			assert.ElementsMatch(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestApplyDataLimits_SendsLimit(t *testing.T) {
+	// Arrange
+	var body []byte
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		body = req.Body
+		return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	applied, errs := client.ApplyDataLimits(context.Background(), map[string]uint64{"1": 5000}, 0)
+
+	// Assert
+	assert.Equal(t, 1, applied)
+	assert.Empty(t, errs)
+	assert.JSONEq(t, `{"limit":{"bytes":5000}}`, string(body))
+}
@@ -0,0 +1,20 @@
+package outline
+
+import (
+	"context"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+)
+
+// checkBodyLimit reports [*ClientError] wrapping [ResponseTooLargeError] if the response body of the method
+// exceeds the limit set by [WithResponseBodyLimitPerMethod] or [WithResponseBodyLimit].
+func (c *Client) checkBodyLimit(ctx context.Context, methodName string, resp *contracts.Response) error {
+	limit, ok := c.bodyLimits[methodName]
+	if !ok {
+		limit = c.bodyLimit
+	}
+	if limit <= 0 || int64(len(resp.Body)) <= limit {
+		return nil
+	}
+	return c.finishClientError(ctx, errResponseTooLarge(resp.StatusCode, len(resp.Body), limit))
+}
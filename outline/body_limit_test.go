@@ -0,0 +1,110 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithResponseBodyLimit Tests ===
+
+func TestResponseBodyLimitPerMethod(t *testing.T) {
+	// A 2 KiB metrics response and a 200 byte error body for DELETE.
+	metricsBody := `{"server":{"locations":[]},"accessKeys":[],"padding":"` + strings.Repeat("x", 2048) + `"}`
+	deleteBody := strings.Repeat("y", 200)
+
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		if req.Method == http.MethodDelete {
+			return &contracts.Response{StatusCode: http.StatusNoContent, Body: []byte(deleteBody)}, nil
+		}
+		return &contracts.Response{StatusCode: http.StatusOK, Body: []byte(metricsBody)}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(mockDoer),
+		WithResponseBodyLimit(1024),
+		WithResponseBodyLimitPerMethod(map[string]int64{
+			"DeleteAccessKey":        100,
+			"GetExperimentalMetrics": 1 << 20,
+		}),
+	)
+	ctx := context.Background()
+
+	t.Run("small DELETE cap rejects oversized body", func(t *testing.T) {
+		// Act
+		err := client.DeleteAccessKey(ctx, "1")
+
+		// Assert
+		var doErr *DoError
+		require.ErrorAs(t, err, &doErr)
+		assert.Equal(t, "delete access key", doErr.Operation())
+		assert.ErrorIs(t, err, ClientOutlineError)
+		assert.ErrorIs(t, err, ResponseTooLargeError)
+		var clientErr *ClientError
+		require.ErrorAs(t, err, &clientErr)
+		assert.Equal(t, http.StatusNoContent, clientErr.StatusCode())
+		assert.Contains(t, clientErr.Error(), "body size: 200 bytes, limit: 100 bytes")
+	})
+
+	t.Run("metrics method allows larger body", func(t *testing.T) {
+		// Act
+		result, err := client.GetExperimentalMetrics(ctx, time.Hour)
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+	})
+
+	t.Run("other methods fall back to the global cap", func(t *testing.T) {
+		// Act
+		_, err := client.GetServerInfo(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, ResponseTooLargeError)
+	})
+}
+
+func TestResponseBodyLimit_Disabled(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{
+		StatusCode: http.StatusOK,
+		Body:       []byte(`{"name":"` + strings.Repeat("x", 4096) + `"}`),
+	}, nil, nil)
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(mockDoer),
+		WithResponseBodyLimit(1024),
+		WithResponseBodyLimitPerMethod(map[string]int64{"GetServerInfo": 0}),
+	)
+
+	// Act
+	_, err := client.GetServerInfo(context.Background())
+
+	// Assert
+	assert.NoError(t, err)
+}
+
+func TestResponseBodyLimit_NegativeRejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		option Option
+	}{
+		{name: "WithResponseBodyLimit", option: WithResponseBodyLimit(-1)},
+		{name: "WithResponseBodyLimitPerMethod", option: WithResponseBodyLimitPerMethod(map[string]int64{"GetServerInfo": -1})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClient("http://localhost:8081/api/", "", tt.option)
+
+			// Assert
+			assert.Nil(t, client)
+			assert.ErrorIs(t, err, InvalidOptionError)
+		})
+	}
+}
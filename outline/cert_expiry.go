@@ -0,0 +1,44 @@
+package outline
+
+import (
+	"context"
+	"time"
+)
+
+// peerCertExpirer is implemented by transports that record
+// the expiry of the server certificate seen in the last TLS handshake.
+type peerCertExpirer interface {
+	LastPeerCertExpiry() (time.Time, bool)
+}
+
+// LastServerCertExpiry returns the NotAfter time of the server leaf certificate
+// verified in the most recent TLS handshake.
+// The boolean is false if no TLS handshake has completed yet
+// or if the configured [Doer] does not report certificates.
+func (c *Client) LastServerCertExpiry() (time.Time, bool) {
+	expirer, ok := c.doer.(peerCertExpirer)
+	if !ok {
+		return time.Time{}, false
+	}
+	return expirer.LastPeerCertExpiry()
+}
+
+// warnCertExpiry logs a warning once per certificate if the server certificate
+// expires within the window configured by [WithCertExpiryWarning].
+func (c *Client) warnCertExpiry(ctx context.Context) {
+	if c.certExpiryWarning <= 0 {
+		return
+	}
+
+	expiry, ok := c.LastServerCertExpiry()
+	remaining := expiry.Sub(c.now())
+	if !ok || remaining > c.certExpiryWarning {
+		return
+	}
+	if c.certExpiryWarned.Swap(expiry.UnixNano()) == expiry.UnixNano() {
+		return
+	}
+
+	c.logger.Infof(ctx, "warning: server certificate expires at %s (in %s)",
+		expiry.Format(time.RFC3339), remaining.Round(time.Second))
+}
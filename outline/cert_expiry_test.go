@@ -0,0 +1,130 @@
+package outline
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	internalhttp "github.com/nepriyatelev/outline-client-go/internal/http"
+	"github.com/nepriyatelev/outline-client-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingLogger is a [Logger] that records formatted Info messages.
+type recordingLogger struct {
+	mu    sync.Mutex
+	infos []string
+}
+
+func (l *recordingLogger) Debugf(_ context.Context, _ string, _ ...any) {}
+
+func (l *recordingLogger) Infof(_ context.Context, format string, args ...any) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.infos = append(l.infos, fmt.Sprintf(format, args...))
+}
+
+// countContaining returns the number of recorded Info messages containing substr.
+func (l *recordingLogger) countContaining(substr string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	n := 0
+	for _, msg := range l.infos {
+		if strings.Contains(msg, substr) {
+			n++
+		}
+	}
+	return n
+}
+
+// newTLSClient creates a Client targeting an HTTPS test server whose certificate expires at notAfter.
+func newTLSClient(t *testing.T, notAfter time.Time, options ...Option) *Client {
+	t.Helper()
+
+	cert := testutil.NewSelfSignedCert(t, notAfter)
+	srv := testutil.NewTLSServer(t, cert, nil)
+	roots := x509.NewCertPool()
+	roots.AddCert(cert.Leaf)
+	doer := internalhttp.NewClient(internalhttp.WithTLSConfig(&tls.Config{RootCAs: roots}))
+
+	return MustNewClient(srv.URL, "secret", append(options, WithClient(doer))...)
+}
+
+// === LastServerCertExpiry Tests ===
+
+func TestLastServerCertExpiry(t *testing.T) {
+	// Arrange
+	notAfter := time.Now().Add(72 * time.Hour).Truncate(time.Second)
+	client := newTLSClient(t, notAfter)
+
+	// Act
+	_, okBefore := client.LastServerCertExpiry()
+	_, err := client.GetServerInfo(context.Background())
+	expiry, okAfter := client.LastServerCertExpiry()
+
+	// Assert
+	require.Error(t, err, "test server does not serve JSON")
+	assert.False(t, okBefore)
+	assert.True(t, okAfter)
+	assert.True(t, notAfter.Equal(expiry), "expiry = %v; want %v", expiry, notAfter)
+}
+
+func TestLastServerCertExpiry_CustomDoer(t *testing.T) {
+	// Arrange
+	client := createTestClientForAccessKeys(NewMockDoer(t))
+
+	// Act
+	_, ok := client.LastServerCertExpiry()
+
+	// Assert
+	assert.False(t, ok)
+}
+
+func TestWithCertExpiryWarning(t *testing.T) {
+	tests := []struct {
+		name          string
+		notAfter      time.Duration
+		within        time.Duration
+		expectedWarns int
+	}{
+		{
+			name:          "expires within window",
+			notAfter:      24 * time.Hour,
+			within:        7 * 24 * time.Hour,
+			expectedWarns: 1,
+		},
+		{
+			name:          "expires after window",
+			notAfter:      30 * 24 * time.Hour,
+			within:        7 * 24 * time.Hour,
+			expectedWarns: 0,
+		},
+		{
+			name:          "warning disabled",
+			notAfter:      time.Hour,
+			within:        0,
+			expectedWarns: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			logger := &recordingLogger{}
+			client := newTLSClient(t, time.Now().Add(tt.notAfter), WithCertExpiryWarning(tt.within), WithLogger(logger))
+
+			// Act
+			_, _ = client.GetServerInfo(context.Background())
+			_, _ = client.GetServerInfo(context.Background())
+
+			// Assert
+			assert.Equal(t, tt.expectedWarns, logger.countContaining("server certificate expires"))
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package outline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/testutil"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithCertSHA256 Tests ===
+
+func TestWithCertSHA256(t *testing.T) {
+	cert := testutil.NewSelfSignedCert(t, time.Now().Add(time.Hour))
+	other := testutil.NewSelfSignedCert(t, time.Now().Add(time.Hour))
+	fingerprint := func(raw []byte) string {
+		sum := sha256.Sum256(raw)
+		return strings.ToUpper(hex.EncodeToString(sum[:]))
+	}
+
+	tests := []struct {
+		name        string
+		fingerprint string
+		expectErr   bool
+	}{
+		{name: "matching fingerprint", fingerprint: fingerprint(cert.Leaf.Raw)},
+		{name: "matching lowercase fingerprint", fingerprint: strings.ToLower(fingerprint(cert.Leaf.Raw))},
+		{name: "mismatched fingerprint", fingerprint: fingerprint(other.Leaf.Raw), expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			srv := testutil.NewTLSServer(t, cert, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"name":"Pinned"}`))
+			}))
+			client, err := NewClient(srv.URL, "secret", WithCertSHA256(tt.fingerprint))
+			require.NoError(t, err)
+
+			// Act
+			info, err := client.GetServerInfo(context.Background())
+
+			// Assert
+			if tt.expectErr {
+				var doErr *DoError
+				require.ErrorAs(t, err, &doErr)
+				assert.Nil(t, info)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &types.ServerInfoResponse{Name: "Pinned"}, info)
+		})
+	}
+}
+
+func TestWithCertSHA256_Rejected(t *testing.T) {
+	valid := strings.Repeat("AB", sha256.Size)
+
+	tests := []struct {
+		name    string
+		options []Option
+	}{
+		{name: "too short", options: []Option{WithCertSHA256(valid[:40])}},
+		{name: "not hexadecimal", options: []Option{WithCertSHA256(valid[:62] + "ZZ")}},
+		{name: "custom transport", options: []Option{WithClient(NewMockDoer(t)), WithCertSHA256(valid)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClient("https://127.0.0.1:1234/", "secret", tt.options...)
+
+			// Assert
+			assert.Nil(t, client)
+			assert.ErrorIs(t, err, InvalidOptionError)
+			assert.Contains(t, err.Error(), "option: WithCertSHA256")
+		})
+	}
+}
+
+// === WithInsecureSkipVerify Tests ===
+
+func TestWithInsecureSkipVerify(t *testing.T) {
+	tests := []struct {
+		name      string
+		skip      bool
+		expectErr bool
+	}{
+		{name: "self-signed certificate accepted", skip: true},
+		{name: "self-signed certificate rejected", skip: false, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			cert := testutil.NewSelfSignedCert(t, time.Now().Add(time.Hour))
+			srv := testutil.NewTLSServer(t, cert, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"name":"Dev"}`))
+			}))
+			client, err := NewClient(srv.URL, "secret", WithInsecureSkipVerify(tt.skip))
+			require.NoError(t, err)
+
+			// Act
+			info, err := client.GetServerInfo(context.Background())
+
+			// Assert
+			if tt.expectErr {
+				var doErr *DoError
+				assert.ErrorAs(t, err, &doErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "Dev", info.Name)
+		})
+	}
+}
+
+func TestWithInsecureSkipVerify_Rejected(t *testing.T) {
+	valid := strings.Repeat("AB", sha256.Size)
+
+	tests := []struct {
+		name    string
+		options []Option
+	}{
+		{name: "with certificate pinning", options: []Option{WithCertSHA256(valid), WithInsecureSkipVerify(true)}},
+		{name: "custom transport", options: []Option{WithClient(NewMockDoer(t)), WithInsecureSkipVerify(true)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClient("https://127.0.0.1:1234/", "secret", tt.options...)
+
+			// Assert
+			assert.Nil(t, client)
+			assert.ErrorIs(t, err, InvalidOptionError)
+			assert.Contains(t, err.Error(), "option: WithInsecureSkipVerify")
+		})
+	}
+}
@@ -3,18 +3,34 @@
 package outline
 
 import (
+	"errors"
 	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/nepriyatelev/outline-client-go/internal/contracts"
 	"github.com/nepriyatelev/outline-client-go/internal/http"
 	"github.com/nepriyatelev/outline-client-go/internal/logger"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
 )
 
 // Client manages authenticated calls to the Outline server API.
 // The zero value is not usable; use [NewClient] or [MustNewClient] to create an instance.
-// Client is safe for concurrent use after construction.
+// Client is safe for concurrent use after construction: its configuration, including the endpoint URLs
+// and the headers set by [WithDefaultHeaders], is never modified once [NewClient] returns, every request
+// is built with its own header map, and the state shared by calls, such as caches, counters and
+// the limiter of [WithRateLimit], is synchronized.
 type Client struct {
-	secret string
+	secret   string
+	basePath string // basePath is the path of the base URL joined with the secret.
+
+	// Endpoint URLs are resolved by initClient and read-only afterwards:
+	// methods that add a query string to one of them work on a copy.
 
 	// Server endpoints
 	//
@@ -56,15 +72,65 @@ type Client struct {
 	// Experimental Metrics
 	getExperimentalMetricsPath *url.URL
 
+	// Behavior
+	syncDeleteExtras       bool
+	requestCompression     bool
+	batchRollback          bool
+	defaultPort            uint16
+	certExpiryWarning      time.Duration
+	clock                  func() time.Time
+	retry                  retryConfig
+	timeout                time.Duration
+	readTimeout            time.Duration
+	writeTimeout           time.Duration
+	contextTimeoutFallback time.Duration
+	operationTimeout       time.Duration
+	bodyLimit              int64
+	bodyLimits             map[string]int64
+	strictJSON             bool
+	notFoundAsNil          bool
+	singleFlight           bool
+	idempotentLimitDelete  bool
+	accessURLHostRewrite   bool
+	maxKeys                int
+	zeroLimitAsUnlimited   bool
+	portCheckTimeout       time.Duration
+	clientName             string
+	userAgent              string
+	defaultHeaders         map[string]string
+
 	// Internal
-	doer   contracts.Doer
-	logger contracts.Logger
+	doer      contracts.Doer
+	logger    contracts.Logger
+	limiter   *rate.Limiter
+	observer  func(RequestInfo)
+	optionErr error
+
+	// ownsDoer and ownsLogger report whether the client created its [Doer] and [Logger],
+	// so that [Client.Close] releases only those.
+	ownsDoer   bool
+	ownsLogger bool
+
+	transportOptions []transportOption
+
+	certExpiryWarned atomic.Int64
+	serverInfo       atomic.Pointer[types.ServerInfoResponse]
+	accessURLHost    atomic.Pointer[string]
+	unmarshalStats   unmarshalStats
+	flights          singleflight.Group
+	keyQuota         keyQuota
+
+	closeOnce sync.Once
+	closeErr  error
 }
 
 // NewClient creates a [Client] that targets baseURL with the provided secret
 // and applies the supplied options.
+// The secret is the bare token of the management API URL, e.g. "AbC123" for
+// https://1.2.3.4:1234/AbC123; surrounding slashes are trimmed.
 //
-// It returns [*ParseURLError] if the baseURL cannot be parsed or joined with the secret.
+// It returns [*ParseURLError] if the baseURL cannot be parsed or joined with the secret,
+// or [*OptionError] if an option was given an invalid value.
 func NewClient(baseURL, secret string, options ...Option) (*Client, error) {
 	return initClient(baseURL, secret, options...)
 }
@@ -79,18 +145,58 @@ func MustNewClient(baseURL, secret string, options ...Option) *Client {
 	return c
 }
 
+// transportOption is an option of the default transport recorded by the [Option] that requested it.
+type transportOption struct {
+	name   string
+	option http.Option
+}
+
+// initTransport creates the default transport with the recorded transport options
+// unless [WithClient] supplied a transport, which the options cannot configure.
+func (c *Client) initTransport() {
+	if c.hasTransportOption("WithInsecureSkipVerify") && c.hasTransportOption("WithCertSHA256") {
+		c.setOptionError(errInvalidOption("WithInsecureSkipVerify",
+			errors.New("skipping certificate verification cannot be combined with WithCertSHA256")))
+		return
+	}
+	if c.doer == nil {
+		httpOptions := make([]http.Option, 0, len(c.transportOptions)+1)
+		httpOptions = append(httpOptions, http.WithUserAgent(c.userAgent))
+		for _, opt := range c.transportOptions {
+			httpOptions = append(httpOptions, opt.option)
+		}
+		c.doer = http.NewClient(httpOptions...)
+		c.ownsDoer = true
+		return
+	}
+	for _, opt := range c.transportOptions {
+		c.setOptionError(errInvalidOption(opt.name,
+			errors.New("option configures the default transport and cannot be combined with WithClient")))
+	}
+}
+
+// hasTransportOption reports whether the [Option] with the name recorded a transport option.
+func (c *Client) hasTransportOption(name string) bool {
+	return slices.ContainsFunc(c.transportOptions, func(opt transportOption) bool {
+		return opt.name == name
+	})
+}
+
 func initClient(baseURL, secret string, options ...Option) (*Client, error) {
 	parsedBase, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, errParseBaseURL(baseURL, err)
 	}
+	// Secrets are sometimes pasted with surrounding slashes; only the bare token is a path segment.
+	secret = strings.Trim(secret, "/")
 	parsedBase.Path, err = url.JoinPath(parsedBase.Path, secret)
 	if err != nil {
 		return nil, errParseBaseURL(baseURL, err)
 	}
 
+	// Endpoint paths are relative to the base path and secret, not to the host root.
 	resolve := func(p string) *url.URL {
-		return parsedBase.ResolveReference(&url.URL{Path: p})
+		return parsedBase.JoinPath(p)
 	}
 
 	var (
@@ -136,7 +242,8 @@ func initClient(baseURL, secret string, options ...Option) (*Client, error) {
 	)
 
 	c := &Client{
-		secret: secret,
+		secret:   secret,
+		basePath: parsedBase.Path,
 
 		// Server endpoints
 		getServerInfoPath:                  resolve(getServerInfoPath),
@@ -164,13 +271,17 @@ func initClient(baseURL, secret string, options ...Option) (*Client, error) {
 		// Experimental Endpoints
 		getExperimentalMetricsPath: resolve(getExperimentalMetricsPath),
 
-		doer:   http.NewClient(),
-		logger: logger.NewNoopLogger(),
+		logger:     logger.NewNoopLogger(),
+		ownsLogger: true,
 	}
 
 	for _, opt := range options {
 		opt(c)
 	}
+	c.initTransport()
+	if c.optionErr != nil {
+		return nil, c.optionErr
+	}
 
 	return c, nil
 }
@@ -0,0 +1,43 @@
+package outline
+
+import (
+	"context"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// ClientOutline is the set of Outline management API operations implemented by [Client],
+// one method per server endpoint. Code that only needs to call the API can depend on it
+// instead of [*Client], e.g. to substitute a fake server in tests.
+// Helpers built on these operations, such as [Client.SyncAccessKeys], are not part of it.
+type ClientOutline interface {
+	// Server
+	GetServerInfo(ctx context.Context) (*types.ServerInfoResponse, error)
+	UpdateServerHostname(ctx context.Context, hostnameOrIP string) error
+	UpdatePortNewAccessKeys(ctx context.Context, port uint16) error
+	UpdateServerName(ctx context.Context, name string) error
+	GetMetricsEnabled(ctx context.Context) (*types.MetricsEnabled, error)
+	UpdateMetricsEnabled(ctx context.Context, enabled bool) error
+	UpdateKeyLimitBytes(ctx context.Context, bytes uint64) error
+	DeleteKeyLimitBytes(ctx context.Context) error
+
+	// Access Keys
+	CreateAccessKey(ctx context.Context, createAccessKey *types.CreateAccessKey) (*types.AccessKey, error)
+	CreateAccessKeyWithID(
+		ctx context.Context, accessKeyID string, createAccessKey *types.CreateAccessKey,
+	) (*types.AccessKey, error)
+	GetAccessKeys(ctx context.Context) ([]*types.AccessKey, error)
+	GetAccessKey(ctx context.Context, accessKeyID string) (*types.AccessKey, error)
+	UpdateAccessKey(ctx context.Context, accessKeyID string, updateAccessKey *types.AccessKey) (*types.AccessKey, error)
+	DeleteAccessKey(ctx context.Context, accessKeyID string) error
+	UpdateNameAccessKey(ctx context.Context, accessKeyID, newName string) error
+	UpdateDataLimitAccessKey(ctx context.Context, accessKeyID string, bytes uint64) error
+	DeleteDataLimitAccessKey(ctx context.Context, accessKeyID string) error
+
+	// Metrics
+	GetMetricsTransfer(ctx context.Context) (*types.MetricsTransfer, error)
+	GetExperimentalMetrics(ctx context.Context, since time.Duration) (*types.ExperimentalMetricsResponse, error)
+}
+
+var _ ClientOutline = (*Client)(nil)
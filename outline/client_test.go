@@ -0,0 +1,131 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === NewClient Tests ===
+
+func TestNewClient_SecretSlashes(t *testing.T) {
+	secrets := []string{"abc", "/abc/", "abc/", "/abc"}
+
+	for _, secret := range secrets {
+		t.Run(secret, func(t *testing.T) {
+			// Act
+			client, err := NewClient("https://example.com:1234/", secret)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, "abc", client.secret)
+			assert.Equal(t, "https://example.com:1234/abc/server", client.getServerInfoPath.String())
+			assert.Equal(t, "https://example.com:1234/abc/access-keys", client.getAccessKeysPath.String())
+			assert.Equal(t, "https://example.com:1234/abc/access-keys/1/data-limit",
+				client.idPath(client.putAccessKeyDataLimitPath, "1"))
+		})
+	}
+}
+
+func TestNewClient_EndpointsKeepBasePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURL  string
+		secret   string
+		expected string
+	}{
+		{
+			name:     "base path with trailing slash",
+			baseURL:  "http://localhost:8081/api/",
+			secret:   "",
+			expected: "http://localhost:8081/api/server",
+		},
+		{
+			name:     "base path without trailing slash",
+			baseURL:  "http://localhost:8081/api",
+			secret:   "secret",
+			expected: "http://localhost:8081/api/secret/server",
+		},
+		{
+			name:     "host only",
+			baseURL:  "http://localhost:8081",
+			secret:   "secret",
+			expected: "http://localhost:8081/secret/server",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClient(tt.baseURL, tt.secret)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, client.getServerInfoPath.String())
+		})
+	}
+}
+
+func TestClient_ConcurrentUse(t *testing.T) {
+	// Arrange
+	const goroutines = 32
+	var (
+		mu   sync.Mutex
+		sent []map[string]string
+	)
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		mu.Lock()
+		sent = append(sent, req.Headers)
+		mu.Unlock()
+		// Mutating the request headers must not affect other requests.
+		req.Headers["X-Seen"] = "1"
+		switch {
+		case req.Method == http.MethodGet:
+			return jsonResponse(http.StatusOK, map[string]any{"accessKeys": []types.AccessKey{{ID: "1"}}}), nil
+		case req.Method == http.MethodPost:
+			return jsonResponse(http.StatusCreated, types.AccessKey{ID: "2"}), nil
+		default:
+			return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+		}
+	})
+	client := MustNewClient("http://localhost:8081/api/", "secret", WithClient(mockDoer),
+		WithDefaultHeaders(map[string]string{"X-Request-ID": "req"}), WithSingleFlight(),
+		WithRateLimit(1e6, goroutines), WithRequestCompression())
+	var wg sync.WaitGroup
+
+	// Act
+	for i := range goroutines {
+		wg.Go(func() {
+			ctx := context.Background()
+			keys, err := client.GetAccessKeys(ctx)
+			if assert.NoError(t, err) {
+				assert.Len(t, keys, 1)
+			}
+			_, err = client.CreateAccessKey(ctx, &types.CreateAccessKey{Name: strings.Repeat("k", i*64)})
+			assert.NoError(t, err)
+			assert.NoError(t, client.DeleteAccessKey(ctx, strconv.Itoa(i)))
+		})
+	}
+	wg.Wait()
+
+	// Assert
+	distinct := make(map[uintptr]struct{}, len(sent))
+	for _, h := range sent {
+		distinct[reflect.ValueOf(h).Pointer()] = struct{}{}
+		assert.Equal(t, "req", h["X-Request-Id"])
+	}
+	assert.Len(t, distinct, len(sent), "requests share a header map")
+	assert.GreaterOrEqual(t, len(sent), 2*goroutines+1)
+	assert.Equal(t, "/api/secret/access-keys/{id}", client.getAccessKeyPath.Path)
+	assert.Equal(t, Headers{"Content-Type": "application/json", "Accept": "application/json"}, DefaultHeaders())
+}
@@ -0,0 +1,11 @@
+package outline
+
+import "time"
+
+// now returns the current time of the clock configured by [WithClock].
+func (c *Client) now() time.Time {
+	if c.clock == nil {
+		return time.Now()
+	}
+	return c.clock()
+}
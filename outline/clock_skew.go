@@ -0,0 +1,64 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+)
+
+// DetectClockSkew estimates how far the server clock is ahead of the client clock
+// (see [WithClock]); a negative value means the server clock is behind.
+// The server time is taken from the Date header of a fresh server information request
+// and compared with the midpoint of the request on the client clock.
+// The Date header has a resolution of one second, so smaller skews are not detected.
+//
+// It returns [*ClientError] wrapping [MissingDateHeaderError] if the response has no parsable Date header,
+// [*ClientError] for unexpected HTTP status codes,
+// or [*DoError] if the HTTP request fails.
+func (c *Client) DetectClockSkew(ctx context.Context) (time.Duration, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	req := &contracts.Request{
+		Method:  http.MethodGet,
+		URL:     c.getServerInfoPath.String(),
+		Headers: DefaultHeaders(),
+		Body:    nil,
+	}
+
+	sent := c.now()
+	resp, err := c.do(ctx, "DetectClockSkew", req)
+	if err != nil {
+		return 0, errDoDetectClockSkew(err)
+	}
+	received := c.now()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, c.finishClientError(ctx, errStatusCode(opDetectClockSkew, resp.StatusCode, resp.Body))
+	}
+
+	date := headerValue(resp.Headers, "Date")
+	serverTime, err := http.ParseTime(date)
+	if err != nil {
+		return 0, c.finishClientError(ctx, errMissingDateHeader(opDetectClockSkew, resp.StatusCode, date))
+	}
+
+	local := sent.Add(received.Sub(sent) / 2)
+	return serverTime.Sub(local).Truncate(time.Second), nil
+}
+
+// headerValue returns the value of the named header, matching the name case-insensitively.
+func headerValue(headers map[string]string, name string) string {
+	if value, ok := headers[name]; ok {
+		return value
+	}
+	for key, value := range headers {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === DetectClockSkew Tests ===
+
+func TestDetectClockSkew(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		expected time.Duration
+	}{
+		{
+			name:     "server ahead",
+			headers:  map[string]string{"Date": now.Add(5 * time.Minute).Format(http.TimeFormat)},
+			expected: 5 * time.Minute,
+		},
+		{
+			name:     "server behind",
+			headers:  map[string]string{"Date": now.Add(-90 * time.Second).Format(http.TimeFormat)},
+			expected: -90 * time.Second,
+		},
+		{
+			name:     "in sync with lowercase header",
+			headers:  map[string]string{"date": now.Format(http.TimeFormat)},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoer(t, &contracts.Response{
+				StatusCode: http.StatusOK,
+				Headers:    tt.headers,
+				Body:       []byte(`{}`),
+			}, nil, nil)
+			client := MustNewClient("http://localhost:8081/api/", "",
+				WithClient(mockDoer),
+				WithClock(func() time.Time { return now }),
+			)
+
+			// Act
+			skew, err := client.DetectClockSkew(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, skew)
+		})
+	}
+}
+
+func TestDetectClockSkew_MissingDateHeader(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+	}{
+		{name: "missing", headers: nil},
+		{name: "invalid", headers: map[string]string{"Date": "yesterday"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoer(t, &contracts.Response{
+				StatusCode: http.StatusOK,
+				Headers:    tt.headers,
+			}, nil, nil)
+			client := createTestClient(mockDoer)
+
+			// Act
+			skew, err := client.DetectClockSkew(context.Background())
+
+			// Assert
+			assert.Zero(t, skew)
+			var clientErr *ClientError
+			require.ErrorAs(t, err, &clientErr)
+			assert.ErrorIs(t, err, ClientOutlineError)
+			assert.ErrorIs(t, err, MissingDateHeaderError)
+		})
+	}
+}
+
+func TestDetectClockSkew_BypassesServerInfoCache(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{
+			StatusCode: http.StatusOK,
+			Headers:    map[string]string{"Date": now.Add(time.Hour).Format(http.TimeFormat)},
+		}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(mockDoer),
+		WithClock(func() time.Time { return now }),
+		WithInitialServerInfo(&types.ServerInfoResponse{Name: "Seeded"}),
+	)
+
+	// Act
+	skew, err := client.DetectClockSkew(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, skew)
+	assert.Equal(t, []string{"GET /server"}, calls)
+}
+
+func TestDetectClockSkew_UnexpectedStatus(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusInternalServerError}, nil, nil)
+	client := createTestClient(mockDoer)
+
+	// Act
+	_, err := client.DetectClockSkew(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+}
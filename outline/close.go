@@ -0,0 +1,46 @@
+package outline
+
+import "errors"
+
+// flusher is implemented by sinks, such as loggers and transports, that buffer data.
+type flusher interface {
+	Flush() error
+}
+
+// closer is implemented by sinks that hold resources to release.
+type closer interface {
+	Close() error
+}
+
+// Close flushes and releases the sinks of the client: its [Logger] and its [Doer].
+// Each sink is flushed if it implements Flush() error. Only the sinks the client created itself,
+// such as the default transport, are then closed if they implement Close() error: the [Logger]
+// set by [WithLogger] and the [Doer] set by [WithClient] belong to the caller and stay open,
+// as they may be shared, e.g. a transport wrapping [net/http.DefaultClient].
+//
+// Callers that configure buffered sinks, e.g. a logger writing an audit trail in batches,
+// must call Close when they are done with the client, or the buffered data may be lost.
+// Close is safe to call more than once; only the first call flushes and closes the sinks,
+// and later calls return its result. The client must not be used after Close.
+//
+// It returns the errors of all sinks joined with [errors.Join].
+func (c *Client) Close() error {
+	c.closeOnce.Do(func() {
+		sinks := []any{c.logger, c.doer}
+		owned := []bool{c.ownsLogger, c.ownsDoer}
+
+		var errs []error
+		for _, sink := range sinks {
+			if f, ok := sink.(flusher); ok {
+				errs = append(errs, f.Flush())
+			}
+		}
+		for i, sink := range sinks {
+			if cl, ok := sink.(closer); ok && owned[i] {
+				errs = append(errs, cl.Close())
+			}
+		}
+		c.closeErr = errors.Join(errs...)
+	})
+	return c.closeErr
+}
@@ -0,0 +1,123 @@
+package outline
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bufferedAuditWriter is a logger that keeps its lines in memory until Flush writes them to out.
+type bufferedAuditWriter struct {
+	buf     bytes.Buffer
+	out     bytes.Buffer
+	flushes int
+	closed  bool
+}
+
+func (w *bufferedAuditWriter) Debugf(context.Context, string, ...any) {}
+
+func (w *bufferedAuditWriter) Infof(_ context.Context, format string, args ...any) {
+	fmt.Fprintf(&w.buf, format+"\n", args...)
+}
+
+func (w *bufferedAuditWriter) Flush() error {
+	w.flushes++
+	_, err := w.buf.WriteTo(&w.out)
+	return err
+}
+
+func (w *bufferedAuditWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+// closingDoer is a transport whose Flush fails with err and whose Close records the call.
+type closingDoer struct {
+	contracts.Doer
+	err    error
+	closed bool
+}
+
+func (d *closingDoer) Flush() error {
+	return d.err
+}
+
+func (d *closingDoer) Close() error {
+	d.closed = true
+	return nil
+}
+
+// === Close Tests ===
+
+func TestClose_FlushesBufferedLogger(t *testing.T) {
+	// Arrange
+	audit := &bufferedAuditWriter{}
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithLogger(audit))
+	require.NoError(t, client.UpdateServerName(context.Background(), "New"))
+	require.Zero(t, audit.out.Len())
+
+	// Act
+	err := client.Close()
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, audit.out.String(), "UpdateServerName: sending request")
+	assert.Zero(t, audit.buf.Len())
+	assert.False(t, audit.closed)
+}
+
+func TestClose_OnlyOnce(t *testing.T) {
+	// Arrange
+	audit := &bufferedAuditWriter{}
+	flushErr := errors.New("flush failed")
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(&closingDoer{err: flushErr}), WithLogger(audit))
+
+	// Act
+	first := client.Close()
+	second := client.Close()
+
+	// Assert
+	assert.ErrorIs(t, first, flushErr)
+	assert.Equal(t, first, second)
+	assert.Equal(t, 1, audit.flushes)
+}
+
+func TestClose_LeavesCallerSinksOpen(t *testing.T) {
+	// Arrange
+	audit := &bufferedAuditWriter{}
+	doer := &closingDoer{}
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(doer), WithLogger(audit))
+
+	// Act
+	err := client.Close()
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, doer.closed)
+	assert.False(t, audit.closed)
+	assert.Equal(t, 1, audit.flushes)
+}
+
+func TestClose_DefaultSinks(t *testing.T) {
+	// Arrange
+	client := MustNewClient("http://localhost:8081/api/", "")
+
+	// Act
+	err := client.Close()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, client.ownsDoer)
+	assert.True(t, client.ownsLogger)
+}
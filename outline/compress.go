@@ -0,0 +1,40 @@
+package outline
+
+import (
+	"bytes"
+	"compress/gzip"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+)
+
+// requestCompressionThreshold is the request body size in bytes
+// above which bodies are gzip-compressed when [WithRequestCompression] is set.
+const requestCompressionThreshold = 1024
+
+// compressibleMethods lists the client methods whose request bodies
+// may be sent with Content-Encoding: gzip.
+var compressibleMethods = map[string]struct{}{
+	"CreateAccessKey":       {},
+	"CreateAccessKeyWithID": {},
+	"UpdateAccessKey":       {},
+}
+
+// compressRequest gzips the request body in place and sets the Content-Encoding header
+// if compression is enabled, the method supports it and the body exceeds the threshold.
+func (c *Client) compressRequest(methodName string, req *contracts.Request) {
+	if !c.requestCompression || len(req.Body) <= requestCompressionThreshold {
+		return
+	}
+	if _, ok := compressibleMethods[methodName]; !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	// Writing to a bytes.Buffer cannot fail.
+	_, _ = zw.Write(req.Body)
+	_ = zw.Close()
+
+	req.Body = buf.Bytes()
+	req.Headers["Content-Encoding"] = "gzip"
+}
@@ -0,0 +1,93 @@
+package outline
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRequestCompression_CreateAccessKey(t *testing.T) {
+	tests := []struct {
+		name             string
+		options          []Option
+		keyName          string
+		expectCompressed bool
+	}{
+		{
+			name:             "large body is compressed",
+			options:          []Option{WithRequestCompression()},
+			keyName:          strings.Repeat("a", 2*requestCompressionThreshold),
+			expectCompressed: true,
+		},
+		{
+			name:             "small body is left uncompressed",
+			options:          []Option{WithRequestCompression()},
+			keyName:          "small",
+			expectCompressed: false,
+		},
+		{
+			name:             "large body without option is left uncompressed",
+			keyName:          strings.Repeat("a", 2*requestCompressionThreshold),
+			expectCompressed: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var capturedReq *contracts.Request
+			mockDoer := newMockDoerAccessKey(t, jsonResponse(http.StatusCreated, types.AccessKey{ID: "1"}), nil, &capturedReq)
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+			createAccessKey := &types.CreateAccessKey{Method: types.MethodAES128GCM, Name: tt.keyName}
+
+			// Act
+			_, err := client.CreateAccessKey(context.Background(), createAccessKey)
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, capturedReq)
+
+			body := capturedReq.Body
+			if tt.expectCompressed {
+				assert.Equal(t, "gzip", capturedReq.Headers["Content-Encoding"])
+				zr, err := gzip.NewReader(bytes.NewReader(body))
+				require.NoError(t, err)
+				body, err = io.ReadAll(zr)
+				require.NoError(t, err)
+			} else {
+				assert.NotContains(t, capturedReq.Headers, "Content-Encoding")
+			}
+
+			var sentBody types.CreateAccessKey
+			require.NoError(t, json.Unmarshal(body, &sentBody))
+			assert.Equal(t, *createAccessKey, sentBody)
+		})
+	}
+}
+
+func TestWithRequestCompression_UnsupportedMethod(t *testing.T) {
+	// Arrange
+	var capturedReq *contracts.Request
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{StatusCode: http.StatusNoContent}, nil, &capturedReq)
+	client := MustNewClient("http://localhost:8081/api/", "", WithRequestCompression(), WithClient(mockDoer))
+	name := strings.Repeat("a", 2*requestCompressionThreshold)
+
+	// Act
+	err := client.UpdateNameAccessKey(context.Background(), "1", name)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, capturedReq)
+	assert.NotContains(t, capturedReq.Headers, "Content-Encoding")
+	assert.Contains(t, string(capturedReq.Body), name)
+}
@@ -0,0 +1,22 @@
+package outline
+
+import "sync"
+
+// runConcurrently calls fn for every index in [0, n) using at most concurrency goroutines
+// and waits for all calls to finish. A concurrency below 1 runs the calls sequentially.
+func runConcurrently(n, concurrency int, fn func(i int)) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range n {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			fn(i)
+		})
+	}
+	wg.Wait()
+}
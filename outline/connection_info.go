@@ -0,0 +1,34 @@
+package outline
+
+import (
+	"context"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// ConnectionInfo returns the public connection details of the server:
+// its name, version, hostname for access keys and port for new access keys.
+// If the server reports no hostname, the hostname of the management API URL is used instead.
+//
+// It returns the errors of [Client.GetServerInfo].
+func (c *Client) ConnectionInfo(ctx context.Context) (*types.ServerConnectionInfo, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	info, err := c.GetServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname := info.HostnameForAccessKeys
+	if hostname == "" {
+		hostname = c.getServerInfoPath.Hostname()
+	}
+
+	return &types.ServerConnectionInfo{
+		Name:     info.Name,
+		Version:  info.Version,
+		Hostname: hostname,
+		Port:     info.PortForNewAccessKeys,
+	}, nil
+}
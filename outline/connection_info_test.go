@@ -0,0 +1,73 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === ConnectionInfo Tests ===
+
+func TestConnectionInfo(t *testing.T) {
+	tests := []struct {
+		name     string
+		hostname string
+		expected *types.ServerConnectionInfo
+	}{
+		{
+			name:     "server hostname",
+			hostname: "vpn.example.com",
+			expected: &types.ServerConnectionInfo{Name: "My Server", Version: "1.12.0", Hostname: "vpn.example.com", Port: 8388},
+		},
+		{
+			name:     "empty hostname falls back to api host",
+			hostname: "",
+			expected: &types.ServerConnectionInfo{Name: "My Server", Version: "1.12.0", Hostname: "localhost", Port: 8388},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+				return jsonResponse(http.StatusOK, types.ServerInfoResponse{
+					Name:                  "My Server",
+					ServerID:              "server-1",
+					MetricsEnabled:        true,
+					CreatedTimestampMs:    1700000000000,
+					Version:               "1.12.0",
+					PortForNewAccessKeys:  8388,
+					HostnameForAccessKeys: tt.hostname,
+				}), nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			info, err := client.ConnectionInfo(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, info)
+		})
+	}
+}
+
+func TestConnectionInfo_Error(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	info, err := client.ConnectionInfo(context.Background())
+
+	// Assert
+	assert.Nil(t, info)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+}
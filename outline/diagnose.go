@@ -0,0 +1,90 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// Diagnose probes the harmless read endpoints used by [Client.GetServerInfo], [Client.GetAccessKeys]
+// and [Client.GetMetricsEnabled] and reports for each whether the server was reachable, the status code
+// and the error, if any. The secret is considered valid if at least one endpoint answered with 2xx;
+// a wrong secret typically makes every endpoint answer 404. The secret is masked in the whole report.
+//
+// Failed probes are part of the report, not an error: Diagnose only returns an error, the context error,
+// if the context ends before all endpoints are probed.
+func (c *Client) Diagnose(ctx context.Context) (*types.Diagnostics, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	probes := []struct {
+		name string
+		url  string
+		call func() error
+	}{
+		{name: "GetServerInfo", url: c.getServerInfoPath.String(), call: func() error {
+			_, err := c.fetchServerInfo(ctx)
+			return err
+		}},
+		{name: "GetAccessKeys", url: c.getAccessKeysPath.String(), call: func() error {
+			_, err := c.GetAccessKeys(ctx)
+			return err
+		}},
+		{name: "GetMetricsEnabled", url: c.getMetricsEnabledPath.String(), call: func() error {
+			_, err := c.GetMetricsEnabled(ctx)
+			return err
+		}},
+	}
+
+	diagnostics := &types.Diagnostics{Endpoints: make([]types.EndpointDiagnostic, 0, len(probes))}
+	for _, probe := range probes {
+		err := probe.call()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
+
+		endpoint := c.diagnoseEndpoint(err)
+		endpoint.Name = probe.name
+		endpoint.URL = maskSecretPath(probe.url, c.secret)
+		if endpoint.StatusCode >= 200 && endpoint.StatusCode < 300 {
+			diagnostics.SecretValid = true
+		}
+		diagnostics.Endpoints = append(diagnostics.Endpoints, endpoint)
+	}
+
+	return diagnostics, nil
+}
+
+// diagnoseEndpoint classifies the outcome of a probe. A [*ClientError] carries the status code the server
+// answered with, even when wrapped in a [*DoError] as for [ResponseTooLargeError]; any other [*DoError]
+// means the server was not reachable, and any other outcome comes from a 200 response.
+func (c *Client) diagnoseEndpoint(err error) types.EndpointDiagnostic {
+	if err == nil {
+		return types.EndpointDiagnostic{Reachable: true, StatusCode: http.StatusOK}
+	}
+
+	endpoint := types.EndpointDiagnostic{Error: c.maskSecret(err.Error())}
+	var doErr *DoError
+	var clientErr *ClientError
+	switch {
+	case errors.As(err, &clientErr):
+		endpoint.Reachable = true
+		endpoint.StatusCode = clientErr.StatusCode()
+	case errors.As(err, &doErr):
+	default:
+		endpoint.Reachable = true
+		endpoint.StatusCode = http.StatusOK
+	}
+	return endpoint
+}
+
+// maskSecret replaces every occurrence of the secret in s with *****.
+func (c *Client) maskSecret(s string) string {
+	if c.secret == "" {
+		return s
+	}
+	return strings.ReplaceAll(s, c.secret, "*****")
+}
@@ -0,0 +1,119 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === Diagnose Tests ===
+
+func TestDiagnose(t *testing.T) {
+	const secret = "s3cr3t"
+
+	tests := []struct {
+		name                string
+		handler             func(req *contracts.Request) (*contracts.Response, error)
+		expected            []types.EndpointDiagnostic
+		expectedSecretValid bool
+	}{
+		{
+			name: "mixed statuses",
+			handler: func(req *contracts.Request) (*contracts.Response, error) {
+				switch {
+				case strings.HasSuffix(req.URL, "/server"):
+					return jsonResponse(http.StatusOK, types.ServerInfoResponse{Name: "server"}), nil
+				case strings.HasSuffix(req.URL, "/access-keys"):
+					return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+				}
+				return nil, errors.New("dial " + req.URL + ": connection refused")
+			},
+			expected: []types.EndpointDiagnostic{
+				{Name: "GetServerInfo", URL: "http://localhost:8081/api/*****/server", Reachable: true, StatusCode: http.StatusOK},
+				{Name: "GetAccessKeys", URL: "http://localhost:8081/api/*****/access-keys", Reachable: true,
+					StatusCode: http.StatusInternalServerError},
+				{Name: "GetMetricsEnabled", URL: "http://localhost:8081/api/*****/metrics/enabled"},
+			},
+			expectedSecretValid: true,
+		},
+		{
+			name: "wrong secret",
+			handler: func(req *contracts.Request) (*contracts.Response, error) {
+				return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+			},
+			expected: []types.EndpointDiagnostic{
+				{Name: "GetServerInfo", URL: "http://localhost:8081/api/*****/server", Reachable: true, StatusCode: http.StatusNotFound},
+				{Name: "GetAccessKeys", URL: "http://localhost:8081/api/*****/access-keys", Reachable: true,
+					StatusCode: http.StatusNotFound},
+				{Name: "GetMetricsEnabled", URL: "http://localhost:8081/api/*****/metrics/enabled", Reachable: true,
+					StatusCode: http.StatusNotFound},
+			},
+			expectedSecretValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newRoutingMockDoer(t, nil, tt.handler)
+			client := MustNewClient("http://localhost:8081/api/", secret, WithClient(mockDoer))
+
+			// Act
+			diagnostics, err := client.Diagnose(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSecretValid, diagnostics.SecretValid)
+			require.Len(t, diagnostics.Endpoints, len(tt.expected))
+			for i, endpoint := range diagnostics.Endpoints {
+				assert.NotContains(t, endpoint.Error, secret)
+				assert.Equal(t, endpoint.StatusCode == http.StatusOK, endpoint.Error == "", endpoint.Name)
+				endpoint.Error = ""
+				assert.Equal(t, tt.expected[i], endpoint)
+			}
+		})
+	}
+}
+
+func TestDiagnose_ResponseTooLarge(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		return jsonResponse(http.StatusOK, types.ServerInfoResponse{Name: strings.Repeat("x", 64)}), nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "secret", WithClient(mockDoer),
+		WithResponseBodyLimitPerMethod(map[string]int64{"GetServerInfo": 16}))
+
+	// Act
+	diagnostics, err := client.Diagnose(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, diagnostics.Endpoints, 3)
+	endpoint := diagnostics.Endpoints[0]
+	assert.Equal(t, "GetServerInfo", endpoint.Name)
+	assert.True(t, endpoint.Reachable)
+	assert.Equal(t, http.StatusOK, endpoint.StatusCode)
+	assert.Contains(t, endpoint.Error, ResponseTooLargeError.Error())
+}
+
+func TestDiagnose_ContextCancelled(t *testing.T) {
+	// Arrange
+	mockDoer := NewMockDoer(t)
+	client := createTestClientForAccessKeys(mockDoer)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	diagnostics, err := client.Diagnose(ctx)
+
+	// Assert
+	assert.Nil(t, diagnostics)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,51 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+)
+
+// do prepares, logs and sends the request through the configured [Doer],
+// bounding it by the configured timeouts and retrying it when [WithRetry] is set.
+// With [WithSingleFlight], concurrent identical GET requests share one round trip.
+// methodName — the name of the calling client function, e.g. "CreateAccessKey".
+// A context that is already done is reported without sending anything.
+func (c *Client) do(ctx context.Context, methodName string, req *contracts.Request) (*contracts.Response, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if c.singleFlight && req.Method == http.MethodGet {
+		return c.doShared(ctx, methodName, req)
+	}
+	return c.send(ctx, methodName, req)
+}
+
+// send implements [Client.do] for a single caller.
+func (c *Client) send(ctx context.Context, methodName string, req *contracts.Request) (*contracts.Response, error) {
+	c.applyDefaultHeaders(req)
+	c.setUserAgent(req)
+
+	c.compressRequest(methodName, req)
+
+	c.logRequest(ctx, methodName, req)
+
+	ctx, cancel := c.withRequestTimeout(ctx, req.Method)
+	defer cancel()
+
+	resp, err := c.sendWithRetry(ctx, methodName, req)
+	if err != nil {
+		// Doer errors may quote the request URL, secret included; [*DoError] reports their message.
+		return nil, c.maskErrorSecret(err)
+	}
+
+	c.warnCertExpiry(ctx)
+
+	if err := c.checkBodyLimit(ctx, methodName, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
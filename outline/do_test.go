@@ -0,0 +1,111 @@
+package outline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// === Context Cancellation Tests ===
+
+func TestDo_CancelledContext(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(ctx context.Context, c *Client) error
+	}{
+		{
+			name: "CreateAccessKey",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.CreateAccessKey(ctx, &types.CreateAccessKey{Method: types.MethodAES128GCM})
+				return err
+			},
+		},
+		{
+			name: "GetAccessKeys",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetAccessKeys(ctx)
+				return err
+			},
+		},
+		{
+			name: "GetServerInfo",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetServerInfo(ctx)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := NewMockDoer(t) // no expectations: any Do call fails the test
+			client := createTestClientForAccessKeys(mockDoer)
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			// Act
+			err := tt.call(ctx, client)
+
+			// Assert
+			var doErr *DoError
+			assert.ErrorAs(t, err, &doErr)
+			assert.ErrorIs(t, err, DoOperationError)
+			assert.ErrorIs(t, err, context.Canceled)
+			mockDoer.AssertNotCalled(t, "Do")
+		})
+	}
+}
+
+// === Nil Response Tests ===
+
+func TestDo_NilResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		call    func(ctx context.Context, c *Client) error
+	}{
+		{
+			name: "GetAccessKeys",
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetAccessKeys(ctx)
+				return err
+			},
+		},
+		{
+			name: "DeleteAccessKey",
+			call: func(ctx context.Context, c *Client) error {
+				return c.DeleteAccessKey(ctx, "1")
+			},
+		},
+		{
+			name:    "GetServerInfo with retry",
+			options: []Option{WithRetry(3, 0)},
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetServerInfo(ctx)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := NewMockDoer(t)
+			mockDoer.On("Do", mock.Anything, mock.Anything).Return(nil, nil).Once()
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+
+			// Act
+			err := tt.call(context.Background(), client)
+
+			// Assert
+			var doErr *DoError
+			require.ErrorAs(t, err, &doErr)
+			assert.ErrorIs(t, err, NilResponseError)
+		})
+	}
+}
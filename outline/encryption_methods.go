@@ -0,0 +1,29 @@
+package outline
+
+import (
+	"context"
+	"slices"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// SupportedEncryptionMethods returns the encryption methods accepted by the server.
+// The Outline API has no dedicated endpoint for them: the list is taken from the
+// supportedEncryptionMethods field of the server information when the server reports it,
+// and falls back to [types.ValidEncryptionMethods] otherwise.
+//
+// It returns the errors of [Client.GetServerInfo].
+func (c *Client) SupportedEncryptionMethods(ctx context.Context) ([]string, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	info, err := c.GetServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(info.SupportedEncryptionMethods) > 0 {
+		return info.SupportedEncryptionMethods, nil
+	}
+	return slices.Clone(types.ValidEncryptionMethods), nil
+}
@@ -0,0 +1,78 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === SupportedEncryptionMethods Tests ===
+
+func TestSupportedEncryptionMethods(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name:     "fallback to static list",
+			body:     `{"name":"server","version":"1.0.0"}`,
+			expected: types.ValidEncryptionMethods,
+		},
+		{
+			name:     "server-provided list",
+			body:     `{"name":"server","supportedEncryptionMethods":["aes-256-gcm","2022-blake3-aes-256-gcm"]}`,
+			expected: []string{"aes-256-gcm", "2022-blake3-aes-256-gcm"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoer(t, &contracts.Response{
+				StatusCode: http.StatusOK,
+				Body:       []byte(tt.body),
+			}, nil, nil)
+			client := createTestClient(mockDoer)
+
+			// Act
+			methods, err := client.SupportedEncryptionMethods(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, methods)
+		})
+	}
+}
+
+func TestSupportedEncryptionMethods_FallbackIsCopy(t *testing.T) {
+	// Arrange
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithInitialServerInfo(&types.ServerInfoResponse{Name: "server"}))
+
+	// Act
+	methods, err := client.SupportedEncryptionMethods(context.Background())
+	require.NoError(t, err)
+	methods[0] = "modified"
+
+	// Assert
+	assert.Equal(t, types.MethodChaCha20IETFPoly1305, types.ValidEncryptionMethods[0])
+}
+
+func TestSupportedEncryptionMethods_Error(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusInternalServerError}, nil, nil)
+	client := createTestClient(mockDoer)
+
+	// Act
+	methods, err := client.SupportedEncryptionMethods(context.Background())
+
+	// Assert
+	assert.Nil(t, methods)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+}
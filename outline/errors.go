@@ -1,15 +1,25 @@
 package outline
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
 )
 
 const (
 	clientOutlineErrStr        = "outline client error"
 	invalidBaseURLErrStr       = "invalid baseURL"
+	invalidOptionErrStr        = "invalid option"
+	invalidArgumentErrStr      = "invalid argument"
 	unmarshalFailedErrStr      = "unmarshal failed"
 	unmarshalEmptyBodyErrStr   = "empty body"
+	unmarshalTrailingDataStr   = "trailing data after JSON value"
 	invalidHostnameErrStr      = "invalid hostname or IP address"
 	internalHostNameErrStr     = "internal error occurred while validating hostname or IP address"
 	invalidPortErrStr          = "requested port wasn't integer from 1 through 65535, or request had no port parameter"
@@ -18,8 +28,24 @@ const (
 	invalidRequestErrStr       = "invalid request"
 	invalidDataLimitErrStr     = "invalid data limit"
 	accessKeyNotFoundErrStr    = "access key not found"
+	accessKeyNameNotFoundStr   = "no access key with the name"
+	multipleAccessKeysErrStr   = "multiple access keys share the name"
+	invalidEncryptionMethodStr = "unsupported encryption method"
+	keyAlreadyExistsErrStr     = "access key already exists"
+	experimentalUnsupportedStr = "experimental metrics unsupported"
+	endpointNotFoundErrStr     = "endpoint not found"
 	unexpectedStatusCodeErrStr = "unexpected status code"
+	missingDateHeaderErrStr    = "missing or invalid Date header"
+	responseTooLargeErrStr     = "response body too large"
+	nilResponseErrStr          = "doer returned neither a response nor an error"
+	keyQuotaExceededErrStr     = "access key quota exceeded"
+	serviceUnavailableErrStr   = "service temporarily unavailable"
 	doOperationErrStr          = "do operation error"
+	batchRolledBackErrStr      = "batch rolled back"
+	rollbackFailedErrStr       = "rollback failed"
+	verificationFailedErrStr   = "verification failed"
+	partialMetricsErrStr       = "metrics partially retrieved"
+	serverNotReadyErrStr       = "server not ready"
 )
 
 var (
@@ -29,12 +55,22 @@ var (
 	// InvalidBaseURLError indicates that the provided base URL is malformed or empty.
 	InvalidBaseURLError = errors.New(invalidBaseURLErrStr)
 
+	// InvalidOptionError indicates that an [Option] was given an invalid value.
+	InvalidOptionError = errors.New(invalidOptionErrStr)
+
+	// InvalidArgumentError indicates that a method argument was rejected before any request was sent.
+	InvalidArgumentError = errors.New(invalidArgumentErrStr)
+
 	// UnmarshalFailedError indicates that JSON unmarshaling failed.
 	UnmarshalFailedError = errors.New(unmarshalFailedErrStr)
 
 	// UnmarshalEmptyBodyError indicates that the response body was empty when data was expected.
 	UnmarshalEmptyBodyError = errors.New(unmarshalEmptyBodyErrStr)
 
+	// UnmarshalTrailingDataError indicates that the response body contained data
+	// after the first JSON value. It is reported when [WithStrictJSON] is set.
+	UnmarshalTrailingDataError = errors.New(unmarshalTrailingDataStr)
+
 	// InvalidHostnameError indicates that the provided hostname or IP address is invalid.
 	InvalidHostnameError = errors.New(invalidHostnameErrStr)
 
@@ -59,26 +95,102 @@ var (
 	// AccessKeyNotFoundError indicates that the requested access key does not exist.
 	AccessKeyNotFoundError = errors.New(accessKeyNotFoundErrStr)
 
+	// AccessKeyNameNotFoundError indicates that no access key has the requested name.
+	// Errors wrapping it also wrap [AccessKeyNotFoundError].
+	AccessKeyNameNotFoundError = errors.New(accessKeyNameNotFoundStr)
+
+	// MultipleAccessKeysError indicates that a lookup by name matched more than one access key.
+	MultipleAccessKeysError = errors.New(multipleAccessKeysErrStr)
+
+	// InvalidEncryptionMethodError indicates that the requested encryption method is neither one of
+	// [types.ValidEncryptionMethods] nor reported by the server.
+	InvalidEncryptionMethodError = errors.New(invalidEncryptionMethodStr)
+
+	// KeyAlreadyExistsError indicates that an access key with the requested ID already exists.
+	KeyAlreadyExistsError = errors.New(keyAlreadyExistsErrStr)
+
+	// ExperimentalMetricsUnsupportedError indicates that the server does not provide
+	// the experimental metrics endpoint.
+	ExperimentalMetricsUnsupportedError = errors.New(experimentalUnsupportedStr)
+
+	// EndpointNotFoundError indicates that the server answered 404 for an endpoint that always exists,
+	// which usually means the secret or the base path of the management API URL is wrong.
+	EndpointNotFoundError = errors.New(endpointNotFoundErrStr)
+
+	// MissingDateHeaderError indicates that the server response has no parsable Date header.
+	MissingDateHeaderError = errors.New(missingDateHeaderErrStr)
+
 	// UnexpectedStatusCodeError indicates that the server returned an unexpected HTTP status code.
 	UnexpectedStatusCodeError = errors.New(unexpectedStatusCodeErrStr)
 
+	// ServiceUnavailableError indicates that the server or a gateway in front of it
+	// answered with 502, 503 or 504. Such failures are transient and the request may be retried.
+	ServiceUnavailableError = errors.New(serviceUnavailableErrStr)
+
+	// ResponseTooLargeError indicates that a response body exceeded the limit set by
+	// [WithResponseBodyLimit] or [WithResponseBodyLimitPerMethod].
+	ResponseTooLargeError = errors.New(responseTooLargeErrStr)
+
+	// KeyQuotaExceededError indicates that creating an access key was refused
+	// because the server already holds the number of keys allowed by [WithMaxKeys].
+	KeyQuotaExceededError = errors.New(keyQuotaExceededErrStr)
+
+	// NilResponseError indicates that the configured [Doer] returned a nil response without an error.
+	// It is reported wrapped in [*DoError] instead of letting the method dereference the nil response.
+	NilResponseError = errors.New(nilResponseErrStr)
+
 	// DoOperationError indicates that the HTTP request execution failed.
 	DoOperationError = errors.New(doOperationErrStr)
+
+	// BatchRolledBackError indicates that a created access key was deleted
+	// because another item of the same batch failed.
+	BatchRolledBackError = errors.New(batchRolledBackErrStr)
+
+	// RollbackFailedError indicates that an access key created by a failed batch could not be deleted.
+	RollbackFailedError = errors.New(rollbackFailedErrStr)
+
+	// VerificationFailedError indicates that the server accepted a change
+	// but reading the resource back showed a different value.
+	VerificationFailedError = errors.New(verificationFailedErrStr)
+
+	// PartialMetricsFailedError indicates that a metrics call returned only part of the metrics
+	// because one of the requests it fans out to failed.
+	PartialMetricsFailedError = errors.New(partialMetricsErrStr)
+
+	// ServerNotReadyError indicates that the server did not answer successfully
+	// before the context of [Client.WaitReady] was done.
+	ServerNotReadyError = errors.New(serverNotReadyErrStr)
 )
 
 // ClientError represents an error returned by the Outline server API.
-// It contains the HTTP status code, response body, and a descriptive message.
+// It contains the failed operation, the HTTP status code, response body, and a descriptive message.
 type ClientError struct {
+	serverName string
+	operation  string
 	statusCode int
 	data       []byte
 	message    string
+	apiErrors  types.APIErrors
 	err        error
 }
 
-// Error returns a formatted error message including status code and response data.
+// Error returns a formatted error message including server name, operation, status code and response data.
+// A response body holding the server error envelope is reported as its code and message instead of raw JSON.
 func (e *ClientError) Error() string {
-	msg := fmt.Sprintf("%s; status code: %d", e.message, e.statusCode)
-	if len(e.data) > 0 {
+	msg := e.message
+	if e.serverName != "" {
+		msg = fmt.Sprintf("%s; server: %s", msg, e.serverName)
+	}
+	if e.operation != "" {
+		msg = fmt.Sprintf("%s; operation: %s", msg, e.operation)
+	}
+	if e.statusCode != 0 {
+		msg = fmt.Sprintf("%s; status code: %d", msg, e.statusCode)
+	}
+	switch {
+	case len(e.data) > 0 && len(e.apiErrors) > 0:
+		msg = fmt.Sprintf("%s; server message: %s", msg, e.apiErrors)
+	case len(e.data) > 0:
 		msg = fmt.Sprintf("%s; data: %s", msg, e.data)
 	}
 	return withLastError(msg, e.err)
@@ -89,9 +201,112 @@ func (e *ClientError) Unwrap() error {
 	return e.err
 }
 
+// ServerName returns the identifier of the server that answered, as set by [WithClientName]
+// or [ContextWithServerName], or an empty string if none is configured.
+func (e *ClientError) ServerName() string {
+	return e.serverName
+}
+
+// Operation returns the name of the operation that failed, e.g. "create access key".
+func (e *ClientError) Operation() string {
+	return e.operation
+}
+
+// StatusCode returns the HTTP status code returned by the server,
+// or zero if the error was detected by the client, e.g. a name lookup without a match.
+func (e *ClientError) StatusCode() int {
+	return e.statusCode
+}
+
+// APIErrors returns the problems the server reported in the response body,
+// whether it sent a single error object or an array of them.
+// It returns nil if the body held no error objects or is not available to the error.
+func (e *ClientError) APIErrors() []types.APIError {
+	return slices.Clone(e.apiErrors)
+}
+
+// maskSecret applies mask to the message, response data and API errors of e.
+func (e *ClientError) maskSecret(mask func(string) string) {
+	e.message = mask(e.message)
+	if len(e.data) > 0 {
+		e.data = []byte(mask(string(e.data)))
+	}
+	for i := range e.apiErrors {
+		e.apiErrors[i].Code = mask(e.apiErrors[i].Code)
+		e.apiErrors[i].Message = mask(e.apiErrors[i].Message)
+	}
+}
+
+// finishClientError completes err before a method returns it: it records the identifier of the
+// targeted server and masks the secret. Every [*ClientError] returned by the client passes through it.
+func (c *Client) finishClientError(ctx context.Context, err *ClientError) *ClientError {
+	c.withServerName(ctx, err)
+	c.maskClientErrorSecret(err)
+	return err
+}
+
+// maskClientErrorSecret masks the secret in the parts of err taken from the response body,
+// as servers may echo the request URL, secret included, in their error responses.
+func (c *Client) maskClientErrorSecret(err *ClientError) {
+	if c.secret != "" {
+		err.maskSecret(c.maskSecret)
+	}
+}
+
+// secretMaskedError reports the message of err with the secret masked
+// while keeping err in the chain for [errors.Is] and [errors.As].
+type secretMaskedError struct {
+	message string
+	err     error
+}
+
+// Error returns the message with the secret masked.
+func (e *secretMaskedError) Error() string {
+	return e.message
+}
+
+// Unwrap returns the underlying error for use with [errors.Is] and [errors.As].
+func (e *secretMaskedError) Unwrap() error {
+	return e.err
+}
+
+// maskErrorSecret returns err with the secret masked in its message,
+// or err itself if the message does not contain the secret.
+func (c *Client) maskErrorSecret(err error) error {
+	if err == nil || c.secret == "" {
+		return err
+	}
+	message := err.Error()
+	if masked := c.maskSecret(message); masked != message {
+		return &secretMaskedError{message: masked, err: err}
+	}
+	return err
+}
+
+// ServerMessage returns what the server said about the failure: the problems reported in the error
+// envelope of the response body, formatted as "code: message" and separated by "; ", or, if the body
+// held no envelope, e.g. a plain text one, the body itself. It returns an empty string if the body
+// was empty or is not available to the error.
+func (e *ClientError) ServerMessage() string {
+	if len(e.apiErrors) > 0 {
+		return e.apiErrors.String()
+	}
+	return string(e.data)
+}
+
+// parseAPIErrors decodes the problems reported in an error response body, or returns nil if there are none.
+func parseAPIErrors(body []byte) types.APIErrors {
+	var apiErrs types.APIErrors
+	if err := json.Unmarshal(body, &apiErrs); err != nil {
+		return nil
+	}
+	return apiErrs
+}
+
 var (
-	errInvalidHostname = func(statusCode int, hostnameOrIP string) *ClientError {
+	errInvalidHostname = func(operation string, statusCode int, hostnameOrIP string) *ClientError {
 		return &ClientError{
+			operation:  operation,
 			statusCode: statusCode,
 			message: fmt.Sprintf("%s: (host name or ip: %s)",
 				ClientOutlineError.Error(),
@@ -100,8 +315,9 @@ var (
 			err: errors.Join(ClientOutlineError, InvalidHostnameError),
 		}
 	}
-	errInternalHostname = func(statusCode int, hostnameOrIP string) *ClientError {
+	errInternalHostname = func(operation string, statusCode int, hostnameOrIP string) *ClientError {
 		return &ClientError{
+			operation:  operation,
 			statusCode: statusCode,
 			message: fmt.Sprintf("%s: (host name or ip: %s)",
 				ClientOutlineError.Error(),
@@ -110,8 +326,9 @@ var (
 			err: errors.Join(ClientOutlineError, InternalHostNameError),
 		}
 	}
-	errInvalidPort = func(statusCode int, port uint16) *ClientError {
+	errInvalidPort = func(operation string, statusCode int, port uint16) *ClientError {
 		return &ClientError{
+			operation:  operation,
 			statusCode: statusCode,
 			message: fmt.Sprintf("%s: (port: %d)",
 				ClientOutlineError.Error(),
@@ -120,8 +337,9 @@ var (
 			err: errors.Join(ClientOutlineError, InvalidPortError),
 		}
 	}
-	errPortAlreadyInUse = func(statusCode int, port uint16) *ClientError {
+	errPortAlreadyInUse = func(operation string, statusCode int, port uint16) *ClientError {
 		return &ClientError{
+			operation:  operation,
 			statusCode: statusCode,
 			message: fmt.Sprintf("%s: (port: %d)",
 				ClientOutlineError.Error(),
@@ -130,8 +348,9 @@ var (
 			err: errors.Join(ClientOutlineError, PortAlreadyInUseError),
 		}
 	}
-	errInvalidServerName = func(statusCode int, name string) *ClientError {
+	errInvalidServerName = func(operation string, statusCode int, name string) *ClientError {
 		return &ClientError{
+			operation:  operation,
 			statusCode: statusCode,
 			message: fmt.Sprintf("%s: (server name: %s)",
 				ClientOutlineError.Error(),
@@ -140,18 +359,21 @@ var (
 			err: errors.Join(ClientOutlineError, InvalidServerNameError),
 		}
 	}
-	errInvalidRequest = func(statusCode int, body string) *ClientError {
+	errInvalidRequest = func(operation string, statusCode int, body string) *ClientError {
 		return &ClientError{
+			operation:  operation,
 			statusCode: statusCode,
 			message: fmt.Sprintf("%s: (response body: %s)",
 				ClientOutlineError.Error(),
 				body,
 			),
-			err: errors.Join(ClientOutlineError, InvalidRequestError),
+			apiErrors: parseAPIErrors([]byte(body)),
+			err:       errors.Join(ClientOutlineError, InvalidRequestError),
 		}
 	}
-	errInvalidDataLimit = func(statusCode int, bytes uint64) *ClientError {
+	errInvalidDataLimit = func(operation string, statusCode int, bytes uint64) *ClientError {
 		return &ClientError{
+			operation:  operation,
 			statusCode: statusCode,
 			message: fmt.Sprintf("%s: (data limit bytes: %d)",
 				ClientOutlineError.Error(),
@@ -160,8 +382,9 @@ var (
 			err: errors.Join(ClientOutlineError, InvalidDataLimitError),
 		}
 	}
-	errAccessKeyNotFound = func(statusCode int, accessKeyID string) *ClientError {
+	errAccessKeyNotFound = func(operation string, statusCode int, accessKeyID string) *ClientError {
 		return &ClientError{
+			operation:  operation,
 			statusCode: statusCode,
 			message: fmt.Sprintf("%s: (access key id: %s)",
 				ClientOutlineError.Error(),
@@ -170,16 +393,146 @@ var (
 			err: errors.Join(ClientOutlineError, AccessKeyNotFoundError),
 		}
 	}
-	errUnexpectedStatusCode = func(statusCode int, data []byte) *ClientError {
+	errKeyAlreadyExists = func(operation string, statusCode int, accessKeyID string) *ClientError {
 		return &ClientError{
+			operation:  operation,
+			statusCode: statusCode,
+			message: fmt.Sprintf("%s: (access key id: %s)",
+				ClientOutlineError.Error(),
+				accessKeyID,
+			),
+			err: errors.Join(ClientOutlineError, KeyAlreadyExistsError),
+		}
+	}
+	errInvalidAccessURL = func(operation string, accessKeyID string, err error) *ClientError {
+		return &ClientError{
+			operation: operation,
+			message: fmt.Sprintf("%s: (access key id: %s)",
+				ClientOutlineError.Error(),
+				accessKeyID,
+			),
+			err: errors.Join(ClientOutlineError, err),
+		}
+	}
+	errExperimentalMetricsUnsupported = func(operation string, statusCode int) *ClientError {
+		return &ClientError{
+			operation:  operation,
+			statusCode: statusCode,
+			message:    fmt.Sprintf("%s: %s", ClientOutlineError.Error(), ExperimentalMetricsUnsupportedError.Error()),
+			err:        errors.Join(ClientOutlineError, ExperimentalMetricsUnsupportedError),
+		}
+	}
+	errEndpointNotFound = func(operation string, statusCode int, data []byte) *ClientError {
+		return &ClientError{
+			operation:  operation,
+			statusCode: statusCode,
+			data:       data,
+			message:    fmt.Sprintf("%s: %s", ClientOutlineError.Error(), EndpointNotFoundError.Error()),
+			apiErrors:  parseAPIErrors(data),
+			err:        errors.Join(ClientOutlineError, EndpointNotFoundError),
+		}
+	}
+	errMissingDateHeader = func(operation string, statusCode int, date string) *ClientError {
+		return &ClientError{
+			operation:  operation,
+			statusCode: statusCode,
+			data:       []byte(date),
+			message:    fmt.Sprintf("%s: %s", ClientOutlineError.Error(), MissingDateHeaderError.Error()),
+			err:        errors.Join(ClientOutlineError, MissingDateHeaderError),
+		}
+	}
+	errUnexpectedStatusCode = func(operation string, statusCode int, data []byte) *ClientError {
+		return &ClientError{
+			operation:  operation,
 			statusCode: statusCode,
 			data:       data,
 			message:    fmt.Sprintf("%s: %s", ClientOutlineError.Error(), UnexpectedStatusCodeError.Error()),
+			apiErrors:  parseAPIErrors(data),
 			err:        errors.Join(ClientOutlineError, UnexpectedStatusCodeError),
 		}
 	}
+	errServiceUnavailable = func(operation string, statusCode int, data []byte) *ClientError {
+		return &ClientError{
+			operation:  operation,
+			statusCode: statusCode,
+			data:       data,
+			message:    fmt.Sprintf("%s: %s", ClientOutlineError.Error(), ServiceUnavailableError.Error()),
+			apiErrors:  parseAPIErrors(data),
+			err:        errors.Join(ClientOutlineError, ServiceUnavailableError),
+		}
+	}
+	// Name lookups are resolved by the client and have no status code.
+	errAccessKeyNameNotFound = func(operation string, name string) *ClientError {
+		return &ClientError{
+			operation: operation,
+			message: fmt.Sprintf("%s: %s (access key name: %q)",
+				ClientOutlineError.Error(),
+				AccessKeyNameNotFoundError.Error(),
+				name,
+			),
+			err: errors.Join(ClientOutlineError, AccessKeyNotFoundError, AccessKeyNameNotFoundError),
+		}
+	}
+	errMultipleAccessKeys = func(operation string, name string, accessKeyIDs []string) *ClientError {
+		return &ClientError{
+			operation: operation,
+			message: fmt.Sprintf("%s: %s (access key name: %q, access key ids: %s)",
+				ClientOutlineError.Error(),
+				MultipleAccessKeysError.Error(),
+				name,
+				strings.Join(accessKeyIDs, ", "),
+			),
+			err: errors.Join(ClientOutlineError, MultipleAccessKeysError),
+		}
+	}
+	// The key quota is enforced by the client, so the refusal has no status code.
+	errKeyQuotaExceeded = func(operation string, count int, limit int) *ClientError {
+		return &ClientError{
+			operation: operation,
+			message: fmt.Sprintf("%s: %s (access keys: %d, limit: %d)",
+				ClientOutlineError.Error(),
+				KeyQuotaExceededError.Error(),
+				count,
+				limit,
+			),
+			err: errors.Join(ClientOutlineError, KeyQuotaExceededError),
+		}
+	}
+	// errNoAccessKeyPort reports a server information response without a port for new access keys.
+	errNoAccessKeyPort = func(operation string) *ClientError {
+		return &ClientError{
+			operation: operation,
+			message:   fmt.Sprintf("%s: server reports no port for new access keys", ClientOutlineError.Error()),
+			err:       errors.Join(ClientOutlineError, InvalidPortError),
+		}
+	}
+	// errResponseTooLarge has no operation: the [*DoError] wrapping it names the failed operation.
+	errResponseTooLarge = func(statusCode int, size int, limit int64) *ClientError {
+		return &ClientError{
+			statusCode: statusCode,
+			message: fmt.Sprintf("%s: %s (body size: %d bytes, limit: %d bytes)",
+				ClientOutlineError.Error(),
+				ResponseTooLargeError.Error(),
+				size,
+				limit,
+			),
+			err: errors.Join(ClientOutlineError, ResponseTooLargeError),
+		}
+	}
 )
 
+// errStatusCode classifies a status code that a method does not handle explicitly.
+// Gateway and availability failures (502, 503 and 504) report [ServiceUnavailableError],
+// any other code reports [UnexpectedStatusCodeError].
+func errStatusCode(operation string, statusCode int, data []byte) *ClientError {
+	switch statusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return errServiceUnavailable(operation, statusCode, data)
+	default:
+		return errUnexpectedStatusCode(operation, statusCode, data)
+	}
+}
+
 // ParseURLError represents an error that occurs when parsing the base URL.
 // It wraps [InvalidBaseURLError] and contains the original URL that failed to parse.
 type ParseURLError struct {
@@ -212,6 +565,65 @@ var errParseBaseURL = func(baseURL string, err error) *ParseURLError {
 	}
 }
 
+// OptionError represents an invalid value passed to an [Option].
+// It wraps [InvalidOptionError] and the reason the value was rejected.
+type OptionError struct {
+	option  string
+	message string
+	err     error
+}
+
+// Error returns a formatted error message including the option name.
+func (e *OptionError) Error() string {
+	msg := fmt.Sprintf("%s; option: %s", e.message, e.option)
+	return withLastError(msg, e.err)
+}
+
+// Unwrap returns the underlying error for use with [errors.Is] and [errors.As].
+func (e *OptionError) Unwrap() error {
+	return e.err
+}
+
+var errInvalidOption = func(option string, err error) *OptionError {
+	return &OptionError{
+		option:  option,
+		message: fmt.Sprintf("%s: %s", ClientOutlineError.Error(), InvalidOptionError.Error()),
+		err:     errors.Join(ClientOutlineError, InvalidOptionError, err),
+	}
+}
+
+// ArgumentError represents an invalid argument passed to a [Client] method.
+// It wraps [InvalidArgumentError] and the reason the argument was rejected.
+type ArgumentError struct {
+	argument string
+	message  string
+	err      error
+}
+
+// Error returns a formatted error message including the argument name.
+func (e *ArgumentError) Error() string {
+	msg := fmt.Sprintf("%s; argument: %s", e.message, e.argument)
+	return withLastError(msg, e.err)
+}
+
+// Unwrap returns the underlying error for use with [errors.Is] and [errors.As].
+func (e *ArgumentError) Unwrap() error {
+	return e.err
+}
+
+var errInvalidArgument = func(argument string, err error) *ArgumentError {
+	return &ArgumentError{
+		argument: argument,
+		message:  fmt.Sprintf("%s: %s", ClientOutlineError.Error(), InvalidArgumentError.Error()),
+		err:      errors.Join(ClientOutlineError, InvalidArgumentError, err),
+	}
+}
+
+// errInvalidEncryptionMethod reports an encryption method that neither this package nor the server supports.
+var errInvalidEncryptionMethod = func(method string) *ArgumentError {
+	return errInvalidArgument("method", fmt.Errorf("%w: %q", InvalidEncryptionMethodError, method))
+}
+
 // UnmarshalError represents an error that occurs when unmarshaling JSON response data.
 // It wraps [UnmarshalFailedError] and contains the raw data that failed to unmarshal.
 type UnmarshalError struct {
@@ -233,6 +645,11 @@ func (e *UnmarshalError) Error() string {
 	return withLastError(msg, e.err)
 }
 
+// Type returns the name of the type the data was decoded into, e.g. "*types.AccessKey".
+func (e *UnmarshalError) Type() string {
+	return e.typeStr
+}
+
 // Unwrap returns the underlying error for use with [errors.Is] and [errors.As].
 func (e *UnmarshalError) Unwrap() error {
 	return e.err
@@ -260,6 +677,7 @@ var (
 
 // DoError represents an error that occurs when executing an HTTP request.
 // It wraps [DoOperationError] and contains the operation name that failed.
+// The secret is masked in the message of the wrapped error.
 type DoError struct {
 	operation string
 	message   string
@@ -277,135 +695,297 @@ func (e *DoError) Unwrap() error {
 	return e.err
 }
 
+// Operation returns the name of the operation that failed, e.g. "create access key".
+func (e *DoError) Operation() string {
+	return e.operation
+}
+
 var (
 	errDoGetServerInfo = func(err error) *DoError {
 		return &DoError{
-			operation: "get server info",
+			operation: opGetServerInfo,
+			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
+			err:       errors.Join(ClientOutlineError, DoOperationError, err),
+		}
+	}
+	errDoDetectClockSkew = func(err error) *DoError {
+		return &DoError{
+			operation: opDetectClockSkew,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoUpdateServerHostname = func(err error) *DoError {
 		return &DoError{
-			operation: "update server hostname",
+			operation: opUpdateServerHostname,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoUpdatePortNewAccessKeys = func(err error) *DoError {
 		return &DoError{
-			operation: "update port for new access keys",
+			operation: opUpdatePortNewAccessKeys,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoUpdateServerName = func(err error) *DoError {
 		return &DoError{
-			operation: "update server name",
+			operation: opUpdateServerName,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoGetMetricsEnabled = func(err error) *DoError {
 		return &DoError{
-			operation: "get metrics enabled",
+			operation: opGetMetricsEnabled,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoUpdateMetricsEnabled = func(err error) *DoError {
 		return &DoError{
-			operation: "update metrics enabled",
+			operation: opUpdateMetricsEnabled,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoUpdateKeyLimitBytes = func(err error) *DoError {
 		return &DoError{
-			operation: "update key limit bytes",
+			operation: opUpdateKeyLimitBytes,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoDeleteKeyLimitBytes = func(err error) *DoError {
 		return &DoError{
-			operation: "delete key limit bytes",
+			operation: opDeleteKeyLimitBytes,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoCreateAccessKey = func(err error) *DoError {
 		return &DoError{
-			operation: "create access key",
+			operation: opCreateAccessKey,
+			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
+			err:       errors.Join(ClientOutlineError, DoOperationError, err),
+		}
+	}
+	errDoCreateAccessKeyWithID = func(err error) *DoError {
+		return &DoError{
+			operation: opCreateAccessKeyWithID,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoGetAccessKeys = func(err error) *DoError {
 		return &DoError{
-			operation: "get access keys",
+			operation: opGetAccessKeys,
+			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
+			err:       errors.Join(ClientOutlineError, DoOperationError, err),
+		}
+	}
+	errDoGetAccessKeysFields = func(err error) *DoError {
+		return &DoError{
+			operation: opGetAccessKeysFields,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoGetAccessKey = func(err error) *DoError {
 		return &DoError{
-			operation: "get access key",
+			operation: opGetAccessKey,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoUpdateAccessKey = func(err error) *DoError {
 		return &DoError{
-			operation: "update access key",
+			operation: opUpdateAccessKey,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoDeleteAccessKey = func(err error) *DoError {
 		return &DoError{
-			operation: "delete access key",
+			operation: opDeleteAccessKey,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoUpdateNameAccessKey = func(err error) *DoError {
 		return &DoError{
-			operation: "update name access key",
+			operation: opUpdateNameAccessKey,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoUpdateDataLimitAccessKey = func(err error) *DoError {
 		return &DoError{
-			operation: "update data limit access key",
+			operation: opUpdateDataLimitAccessKey,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoDeleteDataLimitAccessKey = func(err error) *DoError {
 		return &DoError{
-			operation: "delete data limit access key",
+			operation: opDeleteDataLimitAccessKey,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoGetMetricsTransfer = func(err error) *DoError {
 		return &DoError{
-			operation: "get metrics transfer",
+			operation: opGetMetricsTransfer,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 	errDoGetExperimentalMetrics = func(err error) *DoError {
 		return &DoError{
-			operation: "get experimental metrics",
+			operation: opGetExperimentalMetrics,
 			message:   fmt.Sprintf("%s: %s", ClientOutlineError.Error(), DoOperationError.Error()),
 			err:       errors.Join(ClientOutlineError, DoOperationError, err),
 		}
 	}
 )
 
+// RollbackError represents an item of a batch that was undone after another item failed.
+// It wraps [BatchRolledBackError], the error that triggered the rollback and,
+// if the access key could not be deleted, [RollbackFailedError] with the deletion error.
+type RollbackError struct {
+	accessKeyID string
+	message     string
+	err         error
+}
+
+// Error returns a formatted error message including the rolled back access key ID.
+func (e *RollbackError) Error() string {
+	msg := fmt.Sprintf("%s; (access key id: %s)", e.message, e.accessKeyID)
+	return withLastError(msg, e.err)
+}
+
+// Unwrap returns the underlying error for use with [errors.Is] and [errors.As].
+func (e *RollbackError) Unwrap() error {
+	return e.err
+}
+
+var errRolledBack = func(accessKeyID string, cause, deleteErr error) *RollbackError {
+	err := errors.Join(ClientOutlineError, BatchRolledBackError, cause)
+	if deleteErr != nil {
+		err = errors.Join(ClientOutlineError, BatchRolledBackError, cause, RollbackFailedError, deleteErr)
+	}
+	return &RollbackError{
+		accessKeyID: accessKeyID,
+		message:     fmt.Sprintf("%s: %s", ClientOutlineError.Error(), BatchRolledBackError.Error()),
+		err:         err,
+	}
+}
+
+// VerificationError represents a change that was accepted by the server
+// but not observed when the resource was read back.
+// It wraps [VerificationFailedError].
+type VerificationError struct {
+	accessKeyID string
+	field       string
+	expected    string
+	actual      string
+	message     string
+	err         error
+}
+
+// Error returns a formatted error message including the expected and actual values.
+// A server-wide field, which has no access key ID, omits the ID.
+func (e *VerificationError) Error() string {
+	msg := e.message
+	if e.accessKeyID != "" {
+		msg = fmt.Sprintf("%s; (access key id: %s)", msg, e.accessKeyID)
+	}
+	msg = fmt.Sprintf("%s; field: %s; expected: %s; actual: %s", msg, e.field, e.expected, e.actual)
+	return withLastError(msg, e.err)
+}
+
+// Unwrap returns the underlying error for use with [errors.Is] and [errors.As].
+func (e *VerificationError) Unwrap() error {
+	return e.err
+}
+
+var errVerification = func(accessKeyID, field, expected, actual string) *VerificationError {
+	return &VerificationError{
+		accessKeyID: accessKeyID,
+		field:       field,
+		expected:    expected,
+		actual:      actual,
+		message:     fmt.Sprintf("%s: %s", ClientOutlineError.Error(), VerificationFailedError.Error()),
+		err:         errors.Join(ClientOutlineError, VerificationFailedError),
+	}
+}
+
+// PartialMetricsError represents a metrics call that returned the metrics it could retrieve
+// while the request for another part, named by [PartialMetricsError.Part], failed.
+// It wraps [PartialMetricsFailedError] and the error of the failed request.
+type PartialMetricsError struct {
+	part    string
+	message string
+	err     error
+}
+
+// Error returns a formatted error message including the missing part.
+func (e *PartialMetricsError) Error() string {
+	msg := fmt.Sprintf("%s; missing: %s", e.message, e.part)
+	return withLastError(msg, e.err)
+}
+
+// Unwrap returns the underlying error for use with [errors.Is] and [errors.As].
+func (e *PartialMetricsError) Unwrap() error {
+	return e.err
+}
+
+// Part returns the name of the metrics that could not be retrieved, e.g. "experimental".
+func (e *PartialMetricsError) Part() string {
+	return e.part
+}
+
+var errPartialMetrics = func(part string, err error) *PartialMetricsError {
+	return &PartialMetricsError{
+		part:    part,
+		message: fmt.Sprintf("%s: %s", ClientOutlineError.Error(), PartialMetricsFailedError.Error()),
+		err:     errors.Join(ClientOutlineError, PartialMetricsFailedError, err),
+	}
+}
+
+// NotReadyError represents a [Client.WaitReady] call whose context was done before the server answered.
+// It wraps [ServerNotReadyError], the context error and the last failure seen while polling.
+type NotReadyError struct {
+	attempts int
+	message  string
+	err      error
+}
+
+// Error returns a formatted error message including the number of attempts.
+func (e *NotReadyError) Error() string {
+	msg := fmt.Sprintf("%s; attempts: %d", e.message, e.attempts)
+	return withLastError(msg, e.err)
+}
+
+// Unwrap returns the underlying error for use with [errors.Is] and [errors.As].
+func (e *NotReadyError) Unwrap() error {
+	return e.err
+}
+
+// Attempts returns the number of requests sent to the server information endpoint.
+func (e *NotReadyError) Attempts() int {
+	return e.attempts
+}
+
+var errServerNotReady = func(attempts int, ctxErr, lastErr error) *NotReadyError {
+	return &NotReadyError{
+		attempts: attempts,
+		message:  fmt.Sprintf("%s: %s (%v)", ClientOutlineError.Error(), ServerNotReadyError.Error(), ctxErr),
+		err:      errors.Join(ClientOutlineError, ServerNotReadyError, ctxErr, lastErr),
+	}
+}
+
 func withLastError(message string, err error) string {
 	var lastErr error
 	if uw, ok := err.(interface{ Unwrap() []error }); ok {
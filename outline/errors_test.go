@@ -1,10 +1,17 @@
 package outline
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestErrInvalidHostname(t *testing.T) {
@@ -36,7 +43,7 @@ func TestErrInvalidHostname(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := errInvalidHostname(tt.statusCode, tt.hostnameOrIP)
+			err := errInvalidHostname("", tt.statusCode, tt.hostnameOrIP)
 
 			// Check type
 			assert.IsType(t, &ClientError{}, err)
@@ -87,7 +94,7 @@ func TestErrInternalHostname(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := errInternalHostname(tt.statusCode, tt.hostnameOrIP)
+			err := errInternalHostname("", tt.statusCode, tt.hostnameOrIP)
 
 			// Check type
 			assert.IsType(t, &ClientError{}, err)
@@ -138,7 +145,7 @@ func TestErrInvalidPort(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := errInvalidPort(tt.statusCode, tt.port)
+			err := errInvalidPort("", tt.statusCode, tt.port)
 
 			// Check type
 			assert.IsType(t, &ClientError{}, err)
@@ -189,7 +196,7 @@ func TestErrPortAlreadyInUse(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := errPortAlreadyInUse(tt.statusCode, tt.port)
+			err := errPortAlreadyInUse("", tt.statusCode, tt.port)
 
 			// Check type
 			assert.IsType(t, &ClientError{}, err)
@@ -240,7 +247,7 @@ func TestErrInvalidServerName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
-			err := errInvalidServerName(tt.statusCode, tt.serverName)
+			err := errInvalidServerName("", tt.statusCode, tt.serverName)
 
 			// Check type
 			assert.IsType(t, &ClientError{}, err)
@@ -291,7 +298,7 @@ func TestErrInvalidRequest(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
-			err := errInvalidRequest(tt.statusCode, tt.body)
+			err := errInvalidRequest("", tt.statusCode, tt.body)
 
 			// Check type
 			assert.IsType(t, &ClientError{}, err)
@@ -342,7 +349,7 @@ func TestErrInvalidDataLimit(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
-			err := errInvalidDataLimit(tt.statusCode, tt.bytes)
+			err := errInvalidDataLimit("", tt.statusCode, tt.bytes)
 
 			// Check type
 			assert.IsType(t, &ClientError{}, err)
@@ -393,7 +400,7 @@ func TestErrAccessKeyNotFound(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
-			err := errAccessKeyNotFound(tt.statusCode, tt.accessKeyID)
+			err := errAccessKeyNotFound("", tt.statusCode, tt.accessKeyID)
 
 			// Check type
 			assert.IsType(t, &ClientError{}, err)
@@ -444,7 +451,7 @@ func TestErrUnexpectedStatusCode(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
-			err := errUnexpectedStatusCode(tt.statusCode, tt.data)
+			err := errUnexpectedStatusCode("", tt.statusCode, tt.data)
 
 			// Check type
 			assert.IsType(t, &ClientError{}, err)
@@ -1437,3 +1444,572 @@ func TestWithLastError(t *testing.T) {
 		})
 	}
 }
+
+func TestErrRolledBack(t *testing.T) {
+	cause := errors.New("create failed")
+	deleteErr := errors.New("delete failed")
+
+	tests := []struct {
+		name        string
+		deleteErr   error
+		expectedMsg string
+	}{
+		{
+			name:        "rolled back",
+			deleteErr:   nil,
+			expectedMsg: "outline client error: batch rolled back; (access key id: key-1); reason: create failed.",
+		},
+		{
+			name:        "rollback failed",
+			deleteErr:   deleteErr,
+			expectedMsg: "outline client error: batch rolled back; (access key id: key-1); reason: delete failed.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := errRolledBack("key-1", cause, tt.deleteErr)
+
+			// Check type
+			assert.IsType(t, &RollbackError{}, err)
+
+			// Check error message
+			assert.EqualError(t, err, tt.expectedMsg)
+
+			// Check underlying errors
+			assert.ErrorIs(t, err, ClientOutlineError)
+			assert.ErrorIs(t, err, BatchRolledBackError)
+			assert.ErrorIs(t, err, cause)
+			if tt.deleteErr != nil {
+				assert.ErrorIs(t, err, RollbackFailedError)
+				assert.ErrorIs(t, err, tt.deleteErr)
+			} else {
+				assert.NotErrorIs(t, err, RollbackFailedError)
+			}
+		})
+	}
+}
+
+func TestErrDoCreateAccessKeyWithID(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputErr    error
+		expectedMsg string
+	}{
+		{
+			name:        "with error",
+			inputErr:    errors.New("network error"),
+			expectedMsg: "outline client error: do operation error; operation: create access key with id; reason: network error.",
+		},
+		{
+			name:        "with nil error",
+			inputErr:    nil,
+			expectedMsg: "outline client error: do operation error; operation: create access key with id; reason: do operation error.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := errDoCreateAccessKeyWithID(tt.inputErr)
+
+			// Check type
+			assert.IsType(t, &DoError{}, err)
+
+			// Check operation
+			assert.Equal(t, "create access key with id", err.operation)
+
+			// Check error message
+			assert.EqualError(t, err, tt.expectedMsg)
+
+			// Check underlying errors
+			assert.ErrorIs(t, err.err, ClientOutlineError)
+			assert.ErrorIs(t, err.err, DoOperationError)
+			if tt.inputErr != nil {
+				assert.ErrorIs(t, err.err, tt.inputErr)
+			}
+		})
+	}
+}
+
+func TestErrKeyAlreadyExists(t *testing.T) {
+	tests := []struct {
+		testName    string
+		statusCode  int
+		accessKeyID string
+		expectedMsg string
+	}{
+		{
+			testName:    "valid access key ID",
+			statusCode:  409,
+			accessKeyID: "abc123",
+			expectedMsg: "outline client error: (access key id: abc123); status code: 409; reason: access key already exists.",
+		},
+		{
+			testName:    "empty access key ID",
+			statusCode:  409,
+			accessKeyID: "",
+			expectedMsg: "outline client error: (access key id: ); status code: 409; reason: access key already exists.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			err := errKeyAlreadyExists("", tt.statusCode, tt.accessKeyID)
+
+			// Check type
+			assert.IsType(t, &ClientError{}, err)
+
+			// Check status code
+			assert.Equal(t, tt.statusCode, err.statusCode)
+
+			// Check error message
+			assert.EqualError(t, err, tt.expectedMsg)
+
+			// Check underlying error
+			assert.ErrorIs(t, err.err, ClientOutlineError)
+			assert.ErrorIs(t, err.err, KeyAlreadyExistsError)
+		})
+	}
+}
+
+func TestErrEndpointNotFound(t *testing.T) {
+	// Act
+	err := errEndpointNotFound(opUpdateMetricsEnabled, 404, []byte("not found"))
+
+	// Assert
+	assert.IsType(t, &ClientError{}, err)
+	assert.Equal(t, 404, err.statusCode)
+	assert.EqualError(t, err, "outline client error: endpoint not found; operation: update metrics enabled; status code: 404; data: not found; reason: endpoint not found.")
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, EndpointNotFoundError)
+}
+
+func TestErrExperimentalMetricsUnsupported(t *testing.T) {
+	err := errExperimentalMetricsUnsupported("", 404)
+
+	// Check type
+	assert.IsType(t, &ClientError{}, err)
+
+	// Check status code
+	assert.Equal(t, 404, err.statusCode)
+
+	// Check error message
+	assert.EqualError(t, err, "outline client error: experimental metrics unsupported; status code: 404; reason: experimental metrics unsupported.")
+
+	// Check underlying error
+	assert.ErrorIs(t, err.err, ClientOutlineError)
+	assert.ErrorIs(t, err.err, ExperimentalMetricsUnsupportedError)
+}
+
+func TestErrInvalidOption(t *testing.T) {
+	err := errInvalidOption("WithDefaultPort", InvalidPortError)
+
+	// Check type
+	assert.IsType(t, &OptionError{}, err)
+
+	// Check option
+	assert.Equal(t, "WithDefaultPort", err.option)
+
+	// Check error message
+	assert.EqualError(t, err, "outline client error: invalid option; option: WithDefaultPort; reason: "+invalidPortErrStr+".")
+
+	// Check underlying errors
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, InvalidOptionError)
+	assert.ErrorIs(t, err, InvalidPortError)
+}
+
+func TestErrServiceUnavailable(t *testing.T) {
+	// Arrange & Act
+	err := errServiceUnavailable("", 503, []byte("Service Unavailable"))
+
+	// Assert
+	assert.IsType(t, &ClientError{}, err)
+	assert.Equal(t, 503, err.statusCode)
+	assert.EqualError(t, err, "outline client error: service temporarily unavailable; status code: 503; data: Service Unavailable; reason: service temporarily unavailable.")
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, ServiceUnavailableError)
+	assert.NotErrorIs(t, err, UnexpectedStatusCodeError)
+}
+
+func TestErrStatusCode(t *testing.T) {
+	tests := []struct {
+		testName    string
+		statusCode  int
+		expectedErr error
+	}{
+		{testName: "bad gateway", statusCode: 502, expectedErr: ServiceUnavailableError},
+		{testName: "service unavailable", statusCode: 503, expectedErr: ServiceUnavailableError},
+		{testName: "gateway timeout", statusCode: 504, expectedErr: ServiceUnavailableError},
+		{testName: "internal server error", statusCode: 500, expectedErr: UnexpectedStatusCodeError},
+		{testName: "teapot", statusCode: 418, expectedErr: UnexpectedStatusCodeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			err := errStatusCode("", tt.statusCode, nil)
+
+			assert.Equal(t, tt.statusCode, err.statusCode)
+			assert.ErrorIs(t, err, ClientOutlineError)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestErrInvalidArgument(t *testing.T) {
+	// Arrange
+	reason := errors.New("must not be empty")
+
+	// Act
+	err := errInvalidArgument("name", reason)
+
+	// Assert
+	assert.IsType(t, &ArgumentError{}, err)
+	assert.EqualError(t, err, "outline client error: invalid argument; argument: name; reason: must not be empty.")
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, InvalidArgumentError)
+	assert.ErrorIs(t, err, reason)
+}
+
+func TestErrVerification(t *testing.T) {
+	// Act
+	err := errVerification("key-1", "dataLimit", "100", "50")
+
+	// Assert
+	assert.IsType(t, &VerificationError{}, err)
+	assert.EqualError(t, err, "outline client error: verification failed; (access key id: key-1); field: dataLimit; expected: 100; actual: 50; reason: verification failed.")
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, VerificationFailedError)
+}
+
+func TestErrVerification_ServerField(t *testing.T) {
+	// Act
+	err := errVerification("", "portForNewAccessKeys", "8388", "443")
+
+	// Assert
+	assert.EqualError(t, err, "outline client error: verification failed; field: portForNewAccessKeys; expected: 8388; actual: 443; reason: verification failed.")
+	assert.ErrorIs(t, err, VerificationFailedError)
+}
+
+func TestErrDoGetAccessKeysFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputErr    error
+		expectedMsg string
+	}{
+		{
+			name:        "with error",
+			inputErr:    errors.New("network error"),
+			expectedMsg: "outline client error: do operation error; operation: get access keys fields; reason: network error.",
+		},
+		{
+			name:        "with nil error",
+			inputErr:    nil,
+			expectedMsg: "outline client error: do operation error; operation: get access keys fields; reason: do operation error.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := errDoGetAccessKeysFields(tt.inputErr)
+
+			// Check type
+			assert.IsType(t, &DoError{}, err)
+
+			// Check operation
+			assert.Equal(t, "get access keys fields", err.operation)
+
+			// Check error message
+			assert.EqualError(t, err, tt.expectedMsg)
+
+			// Check underlying errors
+			assert.ErrorIs(t, err.err, ClientOutlineError)
+			assert.ErrorIs(t, err.err, DoOperationError)
+			if tt.inputErr != nil {
+				assert.ErrorIs(t, err.err, tt.inputErr)
+			}
+		})
+	}
+}
+
+func TestErrDoDetectClockSkew(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputErr    error
+		expectedMsg string
+	}{
+		{
+			name:        "with error",
+			inputErr:    errors.New("network error"),
+			expectedMsg: "outline client error: do operation error; operation: detect clock skew; reason: network error.",
+		},
+		{
+			name:        "with nil error",
+			inputErr:    nil,
+			expectedMsg: "outline client error: do operation error; operation: detect clock skew; reason: do operation error.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := errDoDetectClockSkew(tt.inputErr)
+
+			// Check type
+			assert.IsType(t, &DoError{}, err)
+
+			// Check operation
+			assert.Equal(t, "detect clock skew", err.operation)
+
+			// Check error message
+			assert.EqualError(t, err, tt.expectedMsg)
+
+			// Check underlying errors
+			assert.ErrorIs(t, err.err, ClientOutlineError)
+			assert.ErrorIs(t, err.err, DoOperationError)
+			if tt.inputErr != nil {
+				assert.ErrorIs(t, err.err, tt.inputErr)
+			}
+		})
+	}
+}
+
+func TestErrMissingDateHeader(t *testing.T) {
+	// Act
+	err := errMissingDateHeader("", 200, "yesterday")
+
+	// Assert
+	assert.IsType(t, &ClientError{}, err)
+	assert.Equal(t, 200, err.statusCode)
+	assert.EqualError(t, err, "outline client error: missing or invalid Date header; status code: 200; data: yesterday; reason: missing or invalid Date header.")
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, MissingDateHeaderError)
+}
+
+func TestClientError_Accessors(t *testing.T) {
+	// Act
+	err := errUnexpectedStatusCode(opCreateAccessKey, 500, []byte("boom"))
+
+	// Assert
+	assert.Equal(t, "create access key", err.Operation())
+	assert.Equal(t, 500, err.StatusCode())
+	assert.EqualError(t, err, "outline client error: unexpected status code; operation: create access key; status code: 500; data: boom; reason: unexpected status code.")
+}
+
+func TestClientError_StatusCodeFromEveryConstructor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ClientError
+		code int
+	}{
+		{name: "invalid hostname", err: errInvalidHostname(opUpdateServerHostname, 400, "bad"), code: 400},
+		{name: "internal hostname", err: errInternalHostname(opUpdateServerHostname, 500, "host"), code: 500},
+		{name: "invalid port", err: errInvalidPort(opUpdatePortNewAccessKeys, 400, 0), code: 400},
+		{name: "port in use", err: errPortAlreadyInUse(opUpdatePortNewAccessKeys, 409, 8080), code: 409},
+		{name: "invalid server name", err: errInvalidServerName(opUpdateServerName, 400, ""), code: 400},
+		{name: "invalid request", err: errInvalidRequest(opUpdateMetricsEnabled, 400, "bad"), code: 400},
+		{name: "invalid data limit", err: errInvalidDataLimit(opUpdateKeyLimitBytes, 400, 1), code: 400},
+		{name: "access key not found", err: errAccessKeyNotFound(opGetAccessKey, 404, "1"), code: 404},
+		{name: "key already exists", err: errKeyAlreadyExists(opCreateAccessKeyWithID, 409, "1"), code: 409},
+		{name: "experimental unsupported", err: errExperimentalMetricsUnsupported(opGetExperimentalMetrics, 404), code: 404},
+		{name: "endpoint not found", err: errEndpointNotFound(opUpdateMetricsEnabled, 404, nil), code: 404},
+		{name: "missing date header", err: errMissingDateHeader(opGetServerInfo, 200, ""), code: 200},
+		{name: "unexpected status code", err: errUnexpectedStatusCode(opGetServerInfo, 418, nil), code: 418},
+		{name: "service unavailable", err: errServiceUnavailable(opGetServerInfo, 503, nil), code: 503},
+		{name: "status code dispatch", err: errStatusCode(opGetServerInfo, 502, nil), code: 502},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			var clientErr *ClientError
+			ok := errors.As(fmt.Errorf("wrapped: %w", tt.err), &clientErr)
+
+			// Assert
+			require.True(t, ok)
+			assert.Equal(t, tt.code, clientErr.StatusCode())
+			assert.ErrorIs(t, clientErr, ClientOutlineError)
+		})
+	}
+}
+
+func TestClientError_APIErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *ClientError
+		expected []types.APIError
+	}{
+		{
+			name:     "single object",
+			err:      errUnexpectedStatusCode(opCreateAccessKey, 500, []byte(`{"code":"InternalError","message":"boom"}`)),
+			expected: []types.APIError{{Code: "InternalError", Message: "boom"}},
+		},
+		{
+			name: "array",
+			err: errServiceUnavailable(opGetServerInfo, 503, []byte(
+				`[{"code":"Unavailable","message":"restarting"},{"code":"Unavailable","message":"try later"}]`)),
+			expected: []types.APIError{
+				{Code: "Unavailable", Message: "restarting"},
+				{Code: "Unavailable", Message: "try later"},
+			},
+		},
+		{
+			name:     "invalid request body",
+			err:      errInvalidRequest(opUpdateMetricsEnabled, 400, `{"code":"InvalidArgument","message":"bad flag"}`),
+			expected: []types.APIError{{Code: "InvalidArgument", Message: "bad flag"}},
+		},
+		{
+			name:     "plain text body",
+			err:      errUnexpectedStatusCode(opCreateAccessKey, 500, []byte("boom")),
+			expected: nil,
+		},
+		{
+			name:     "constructor without body",
+			err:      errAccessKeyNotFound(opGetAccessKey, 404, "1"),
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			apiErrs := tt.err.APIErrors()
+
+			// Assert
+			assert.Equal(t, tt.expected, apiErrs)
+		})
+	}
+}
+
+func TestDoError_Operation(t *testing.T) {
+	// Act
+	err := errDoDeleteAccessKey(errors.New("network error"))
+
+	// Assert
+	assert.Equal(t, "delete access key", err.Operation())
+}
+
+func TestClientError_OperationFromMethods(t *testing.T) {
+	tests := []struct {
+		operation   string
+		status      int
+		expectedErr error
+		call        func(ctx context.Context, c *Client) error
+	}{
+		{
+			operation:   "create access key",
+			status:      http.StatusInternalServerError,
+			expectedErr: UnexpectedStatusCodeError,
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.CreateAccessKey(ctx, &types.CreateAccessKey{})
+				return err
+			},
+		},
+		{
+			operation:   "get access key",
+			status:      http.StatusNotFound,
+			expectedErr: AccessKeyNotFoundError,
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetAccessKey(ctx, "1")
+				return err
+			},
+		},
+		{
+			operation:   "update data limit access key",
+			status:      http.StatusBadRequest,
+			expectedErr: InvalidDataLimitError,
+			call: func(ctx context.Context, c *Client) error {
+				return c.UpdateDataLimitAccessKey(ctx, "1", 10)
+			},
+		},
+		{
+			operation:   "update port for new access keys",
+			status:      http.StatusConflict,
+			expectedErr: PortAlreadyInUseError,
+			call: func(ctx context.Context, c *Client) error {
+				return c.UpdatePortNewAccessKeys(ctx, 8080)
+			},
+		},
+		{
+			operation:   "get experimental metrics",
+			status:      http.StatusServiceUnavailable,
+			expectedErr: ServiceUnavailableError,
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetExperimentalMetrics(ctx, time.Hour)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.operation, func(t *testing.T) {
+			// Arrange
+			mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+				return &contracts.Response{StatusCode: tt.status}, nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			err := tt.call(context.Background(), client)
+
+			// Assert
+			var clientErr *ClientError
+			require.ErrorAs(t, err, &clientErr)
+			assert.Equal(t, tt.operation, clientErr.Operation())
+			assert.Equal(t, tt.status, clientErr.StatusCode())
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestClientError_ServerMessage(t *testing.T) {
+	tests := []struct {
+		name            string
+		data            []byte
+		expectedMessage string
+		expectedErr     string
+	}{
+		{
+			name:            "JSON envelope",
+			data:            []byte(`{"code":"InvalidArgument","message":"Parameter name must be a string"}`),
+			expectedMessage: "InvalidArgument: Parameter name must be a string",
+			expectedErr: "outline client error: unexpected status code; operation: create access key; status code: 400; " +
+				"server message: InvalidArgument: Parameter name must be a string; reason: unexpected status code.",
+		},
+		{
+			name:            "JSON envelope array",
+			data:            []byte(`[{"code":"InvalidArgument","message":"bad port"},{"message":"port in use"}]`),
+			expectedMessage: "InvalidArgument: bad port; port in use",
+			expectedErr: "outline client error: unexpected status code; operation: create access key; status code: 400; " +
+				"server message: InvalidArgument: bad port; port in use; reason: unexpected status code.",
+		},
+		{
+			name:            "JSON without envelope",
+			data:            []byte(`{"error":"unprocessable"}`),
+			expectedMessage: `{"error":"unprocessable"}`,
+			expectedErr: "outline client error: unexpected status code; operation: create access key; status code: 400; " +
+				`data: {"error":"unprocessable"}; reason: unexpected status code.`,
+		},
+		{
+			name:            "plain text body",
+			data:            []byte("Bad Request"),
+			expectedMessage: "Bad Request",
+			expectedErr: "outline client error: unexpected status code; operation: create access key; status code: 400; " +
+				"data: Bad Request; reason: unexpected status code.",
+		},
+		{
+			name:        "empty body",
+			expectedErr: "outline client error: unexpected status code; operation: create access key; status code: 400; reason: unexpected status code.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := errUnexpectedStatusCode(opCreateAccessKey, http.StatusBadRequest, tt.data)
+
+			// Assert
+			assert.Equal(t, tt.expectedMessage, err.ServerMessage())
+			assert.EqualError(t, err, tt.expectedErr)
+		})
+	}
+}
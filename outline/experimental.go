@@ -2,6 +2,8 @@ package outline
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -11,9 +13,50 @@ import (
 
 // === Experimental Metrics ===
 
+// GetExperimentalMetrics retrieves the experimental server and access key metrics
+// collected over the since window.
+//
+// It returns [*ClientError] with code 404 wrapping [ExperimentalMetricsUnsupportedError]
+// if the server does not provide the experimental endpoint,
+// [*ClientError] for other unexpected HTTP status codes,
+// [*UnmarshalError] if JSON parsing fails,
+// or [*DoError] if the HTTP request fails.
 func (c *Client) GetExperimentalMetrics(ctx context.Context, since time.Duration) (
 	*types.ExperimentalMetricsResponse, error,
 ) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	body, err := c.getExperimentalMetrics(ctx, "GetExperimentalMetrics", since)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalJSONWithError[types.ExperimentalMetricsResponse](body, c.unmarshalOptions()...)
+}
+
+// GetExperimentalMetricsRaw retrieves the experimental metrics collected over the since window
+// as the JSON body sent by the server, without decoding it into [types.ExperimentalMetricsResponse].
+// This suits tools that forward the metrics, e.g. to a frontend, and keeps fields that the client
+// types do not know yet. The body is only checked to be valid JSON.
+//
+// It returns the same errors as [Client.GetExperimentalMetrics].
+func (c *Client) GetExperimentalMetricsRaw(ctx context.Context, since time.Duration) (json.RawMessage, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	body, err := c.getExperimentalMetrics(ctx, "GetExperimentalMetricsRaw", since)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := unmarshalJSONWithError[json.RawMessage](body, c.unmarshalOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	return *raw, nil
+}
+
+// getExperimentalMetrics requests the experimental metrics and returns the body of a 200 response.
+func (c *Client) getExperimentalMetrics(ctx context.Context, methodName string, since time.Duration) ([]byte, error) {
 	requestURL := *c.getExperimentalMetricsPath
 	sinceQueryParamName := "since"
 	q := requestURL.Query()
@@ -27,17 +70,59 @@ func (c *Client) GetExperimentalMetrics(ctx context.Context, since time.Duration
 		Body:    nil,
 	}
 
-	c.logRequest(ctx, "GetExperimentalMetrics", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, methodName, req)
 	if err != nil {
 		return nil, errDoGetExperimentalMetrics(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return unmarshalJSONWithError[types.ExperimentalMetricsResponse](resp.Body)
+		return resp.Body, nil
+	case http.StatusNotFound:
+		return nil, c.finishClientError(ctx, errExperimentalMetricsUnsupported(opGetExperimentalMetrics, http.StatusNotFound))
 	default:
-		return nil, errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return nil, c.finishClientError(ctx, errStatusCode(opGetExperimentalMetrics, resp.StatusCode, resp.Body))
+	}
+}
+
+// GetExperimentalMetricsSince retrieves the experimental metrics collected since start.
+// The window is computed from start to the current time of the client clock (see [WithClock])
+// and passed to [Client.GetExperimentalMetrics].
+//
+// It returns [*ArgumentError] wrapping [InvalidArgumentError] if start is not in the past,
+// and otherwise the errors of [Client.GetExperimentalMetrics].
+func (c *Client) GetExperimentalMetricsSince(ctx context.Context, start time.Time) (
+	*types.ExperimentalMetricsResponse, error,
+) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	since := c.now().Sub(start)
+	if since <= 0 {
+		return nil, errInvalidArgument("start", fmt.Errorf("start %s is not in the past", start.Format(time.RFC3339)))
+	}
+
+	return c.GetExperimentalMetrics(ctx, since)
+}
+
+// GetPeakDeviceCounts returns the peak number of simultaneously connected devices
+// for every access key over the since window, keyed by access key ID.
+//
+// It returns the errors of [Client.GetExperimentalMetrics], including [*ClientError]
+// wrapping [ExperimentalMetricsUnsupportedError] if the server lacks the experimental endpoint.
+func (c *Client) GetPeakDeviceCounts(ctx context.Context, since time.Duration) (map[int64]int64, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	metrics, err := c.GetExperimentalMetrics(ctx, since)
+	if err != nil {
+		return nil, err
 	}
+
+	counts := make(map[int64]int64, len(metrics.AccessKeys))
+	for _, key := range metrics.AccessKeys {
+		counts[key.AccessKeyID] = key.Connection.PeakDeviceCount.Data
+	}
+
+	return counts, nil
 }
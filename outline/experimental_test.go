@@ -0,0 +1,245 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === GetExperimentalMetrics Tests ===
+
+func TestGetExperimentalMetrics_Success(t *testing.T) {
+	// Arrange
+	var capturedReq *contracts.Request
+	mockDoer := newMockDoer(t, &contracts.Response{
+		StatusCode: http.StatusOK,
+		Body:       []byte(`{"server":{"locations":[]},"accessKeys":[{"accessKeyId":1}]}`),
+	}, nil, &capturedReq)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.GetExperimentalMetrics(context.Background(), 24*time.Hour)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, result.AccessKeys, 1)
+	assert.Equal(t, int64(1), result.AccessKeys[0].AccessKeyID)
+	assert.Equal(t, http.MethodGet, capturedReq.Method)
+	assert.Equal(t, "http://localhost:8081/api/experimental/server/metrics?since=24h", capturedReq.URL)
+}
+
+func TestGetExperimentalMetrics_Unsupported(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusNotFound}, nil, nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.GetExperimentalMetrics(context.Background(), time.Hour)
+
+	// Assert
+	assert.Nil(t, result)
+	var clientErr *ClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, http.StatusNotFound, clientErr.statusCode)
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, ExperimentalMetricsUnsupportedError)
+}
+
+func TestGetExperimentalMetrics_DoerError(t *testing.T) {
+	// Arrange
+	networkError := errors.New("network error")
+	mockDoer := newMockDoer(t, nil, networkError, nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.GetExperimentalMetrics(context.Background(), time.Hour)
+
+	// Assert
+	assert.Nil(t, result)
+	var doErr *DoError
+	assert.ErrorAs(t, err, &doErr)
+	assert.ErrorIs(t, err, networkError)
+}
+
+// === GetExperimentalMetricsRaw Tests ===
+
+func TestGetExperimentalMetricsRaw_Success(t *testing.T) {
+	// Arrange
+	body := `{"server":{"tunnelTime":{"seconds":10},"futureField":{"nested":[1,2,3]}},"accessKeys":[]}`
+	var capturedReq *contracts.Request
+	mockDoer := newMockDoer(t, &contracts.Response{
+		StatusCode: http.StatusOK,
+		Body:       []byte(body),
+	}, nil, &capturedReq)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	raw, err := client.GetExperimentalMetricsRaw(context.Background(), time.Hour)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, body, string(raw))
+	assert.Equal(t, "http://localhost:8081/api/experimental/server/metrics?since=1h", capturedReq.URL)
+}
+
+func TestGetExperimentalMetricsRaw_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		resp        *contracts.Response
+		doErr       error
+		expectedErr error
+	}{
+		{
+			name:        "not found",
+			resp:        &contracts.Response{StatusCode: http.StatusNotFound},
+			expectedErr: ExperimentalMetricsUnsupportedError,
+		},
+		{
+			name:        "unexpected status",
+			resp:        &contracts.Response{StatusCode: http.StatusInternalServerError},
+			expectedErr: UnexpectedStatusCodeError,
+		},
+		{
+			name:        "invalid json",
+			resp:        &contracts.Response{StatusCode: http.StatusOK, Body: []byte(`{"server":`)},
+			expectedErr: UnmarshalFailedError,
+		},
+		{name: "doer error", doErr: errors.New("network error"), expectedErr: DoOperationError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoer(t, tt.resp, tt.doErr, nil)
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			raw, err := client.GetExperimentalMetricsRaw(context.Background(), time.Hour)
+
+			// Assert
+			assert.Nil(t, raw)
+			assert.ErrorIs(t, err, ClientOutlineError)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+// === GetPeakDeviceCounts Tests ===
+
+// === GetExperimentalMetricsSince Tests ===
+
+func TestGetExperimentalMetricsSince_PastStart(t *testing.T) {
+	// Arrange
+	now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+	var capturedReq *contracts.Request
+	mockDoer := newMockDoer(t, &contracts.Response{
+		StatusCode: http.StatusOK,
+		Body:       []byte(`{"server":{"locations":[]},"accessKeys":[]}`),
+	}, nil, &capturedReq)
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(mockDoer),
+		WithClock(func() time.Time { return now }),
+	)
+
+	// Act
+	result, err := client.GetExperimentalMetricsSince(context.Background(), now.Add(-36*time.Hour))
+
+	// Assert
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, "http://localhost:8081/api/experimental/server/metrics?since=36h", capturedReq.URL)
+}
+
+func TestGetExperimentalMetricsSince_FutureStart(t *testing.T) {
+	tests := []struct {
+		name  string
+		start time.Duration
+	}{
+		{name: "future", start: time.Hour},
+		{name: "now", start: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			now := time.Date(2026, 1, 2, 12, 0, 0, 0, time.UTC)
+			mockDoer := NewMockDoer(t)
+			client := MustNewClient("http://localhost:8081/api/", "",
+				WithClient(mockDoer),
+				WithClock(func() time.Time { return now }),
+			)
+
+			// Act
+			result, err := client.GetExperimentalMetricsSince(context.Background(), now.Add(tt.start))
+
+			// Assert
+			assert.Nil(t, result)
+			var argErr *ArgumentError
+			require.ErrorAs(t, err, &argErr)
+			assert.ErrorIs(t, err, ClientOutlineError)
+			assert.ErrorIs(t, err, InvalidArgumentError)
+			assert.Contains(t, err.Error(), "argument: start")
+			mockDoer.AssertNotCalled(t, "Do")
+		})
+	}
+}
+
+func TestGetPeakDeviceCounts_Success(t *testing.T) {
+	tests := []struct {
+		name     string
+		metrics  types.ExperimentalMetricsResponse
+		expected map[int64]int64
+	}{
+		{
+			name: "several keys",
+			metrics: types.ExperimentalMetricsResponse{
+				AccessKeys: []types.AccessKeyMetrics{
+					{AccessKeyID: 1, Connection: types.ConnectionMetrics{PeakDeviceCount: types.PeakDeviceCount{Data: 3}}},
+					{AccessKeyID: 2, Connection: types.ConnectionMetrics{PeakDeviceCount: types.PeakDeviceCount{Data: 0}}},
+					{AccessKeyID: 7, Connection: types.ConnectionMetrics{PeakDeviceCount: types.PeakDeviceCount{Data: 12}}},
+				},
+			},
+			expected: map[int64]int64{1: 3, 2: 0, 7: 12},
+		},
+		{
+			name:     "no keys",
+			metrics:  types.ExperimentalMetricsResponse{},
+			expected: map[int64]int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoer(t, jsonResponse(http.StatusOK, tt.metrics), nil, nil)
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			result, err := client.GetPeakDeviceCounts(context.Background(), time.Hour)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestGetPeakDeviceCounts_Unsupported(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusNotFound}, nil, nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.GetPeakDeviceCounts(context.Background(), time.Hour)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, ExperimentalMetricsUnsupportedError)
+}
@@ -1,12 +1,52 @@
 package outline
 
+import (
+	"cmp"
+
+	"github.com/nepriyatelev/outline-client-go/internal/http"
+)
+
+// userAgentHeader is the request header identifying the client to the server.
+const userAgentHeader = "User-Agent"
+
 // Headers represents a map of HTTP headers.
 type Headers map[string]string
 
 // DefaultHeaders returns the default HTTP headers used for API requests.
+// Each call returns a new map, which the caller may modify.
 func DefaultHeaders() Headers {
 	return Headers{
 		"Content-Type": "application/json",
 		"Accept":       "application/json",
 	}
 }
+
+// applyDefaultHeaders adds the headers set by [WithDefaultHeaders] to the request headers.
+// Of the headers the request already carries, only those from [DefaultHeaders] are replaced.
+func (c *Client) applyDefaultHeaders(req *Request) {
+	if len(c.defaultHeaders) == 0 {
+		return
+	}
+	if req.Headers == nil {
+		req.Headers = make(map[string]string, len(c.defaultHeaders))
+	}
+	defaults := DefaultHeaders()
+	for name, value := range c.defaultHeaders {
+		if current, ok := req.Headers[name]; ok && current != defaults[name] {
+			continue
+		}
+		req.Headers[name] = value
+	}
+}
+
+// setUserAgent adds the User-Agent header set by [WithUserAgent], or the default one,
+// unless the request already carries it, so that every [Doer] identifies the client the same way.
+func (c *Client) setUserAgent(req *Request) {
+	if _, ok := req.Headers[userAgentHeader]; ok {
+		return
+	}
+	if req.Headers == nil {
+		req.Headers = make(map[string]string, 1)
+	}
+	req.Headers[userAgentHeader] = cmp.Or(c.userAgent, http.DefaultUserAgent)
+}
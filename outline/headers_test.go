@@ -0,0 +1,153 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === User-Agent Tests ===
+
+func TestWithUserAgent(t *testing.T) {
+	tests := []struct {
+		name              string
+		options           []Option
+		expectedUserAgent string
+	}{
+		{name: "default", expectedUserAgent: "outline-go-client/1.0"},
+		{name: "custom", options: []Option{WithUserAgent("billing-sync/2.3")}, expectedUserAgent: "billing-sync/2.3"},
+		{name: "empty falls back to default", options: []Option{WithUserAgent("")}, expectedUserAgent: "outline-go-client/1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var captured *contracts.Request
+			mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusNoContent}, nil, &captured)
+			client := MustNewClient("http://localhost:8081/api/", "secret", append(tt.options, WithClient(mockDoer))...)
+
+			// Act
+			err := client.UpdateServerName(context.Background(), "name")
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, captured)
+			assert.Equal(t, tt.expectedUserAgent, captured.Headers["User-Agent"])
+			assert.Equal(t, "application/json", captured.Headers["Content-Type"])
+		})
+	}
+}
+
+func TestWithUserAgent_DefaultTransport(t *testing.T) {
+	// Arrange
+	userAgents := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgents <- r.UserAgent()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+	client := MustNewClient(srv.URL, "secret", WithUserAgent("billing-sync/2.3"))
+
+	// Act
+	err := client.UpdateServerName(context.Background(), "name")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "billing-sync/2.3", <-userAgents)
+}
+
+// === Default Headers Tests ===
+
+func TestWithDefaultHeaders(t *testing.T) {
+	// Arrange
+	var (
+		calls   []string
+		headers []map[string]string
+	)
+	mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+		headers = append(headers, req.Headers)
+		switch req.Method {
+		case http.MethodPost:
+			return jsonResponse(http.StatusCreated, types.AccessKey{ID: "1"}), nil
+		case http.MethodGet:
+			return jsonResponse(http.StatusOK, types.AccessKey{ID: "1"}), nil
+		default:
+			return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+		}
+	})
+	userHeaders := map[string]string{"authorization": "Bearer token", "X-Request-ID": "req-42"}
+	client := MustNewClient("http://localhost:8081/api/", "secret",
+		WithClient(mockDoer), WithDefaultHeaders(userHeaders))
+	userHeaders["X-Request-ID"] = "changed"
+	ctx := ContextWithIdempotencyKey(context.Background(), "idem-1")
+
+	// Act
+	_, createErr := client.CreateAccessKey(ctx, &types.CreateAccessKey{Name: "alice"})
+	_, getErr := client.GetAccessKey(ctx, "1")
+	deleteErr := client.DeleteAccessKey(ctx, "1")
+
+	// Assert
+	require.NoError(t, createErr)
+	require.NoError(t, getErr)
+	require.NoError(t, deleteErr)
+	assert.Equal(t, []string{"POST /secret/access-keys", "GET /secret/access-keys/1", "DELETE /secret/access-keys/1"}, calls)
+	for i, h := range headers {
+		assert.Equal(t, "Bearer token", h["Authorization"], calls[i])
+		assert.Equal(t, "req-42", h["X-Request-Id"], calls[i])
+		assert.Equal(t, "application/json", h["Content-Type"], calls[i])
+		assert.Equal(t, "application/json", h["Accept"], calls[i])
+	}
+	assert.Equal(t, "idem-1", headers[0]["Idempotency-Key"])
+}
+
+func TestWithDefaultHeaders_Precedence(t *testing.T) {
+	// Arrange
+	var captured *contracts.Request
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusCreated, Body: []byte(`{"id":"1"}`)}, nil, &captured)
+	client := MustNewClient("http://localhost:8081/api/", "secret", WithClient(mockDoer), WithDefaultHeaders(map[string]string{
+		"accept":          "application/vnd.outline+json",
+		"Idempotency-Key": "from-defaults",
+		"User-Agent":      "gateway-client/1.0",
+	}))
+	ctx := ContextWithIdempotencyKey(context.Background(), "from-context")
+
+	// Act
+	_, err := client.CreateAccessKey(ctx, &types.CreateAccessKey{})
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, captured)
+	assert.Equal(t, "application/vnd.outline+json", captured.Headers["Accept"])
+	assert.Equal(t, "application/json", captured.Headers["Content-Type"])
+	assert.Equal(t, "from-context", captured.Headers["Idempotency-Key"])
+	assert.Equal(t, "gateway-client/1.0", captured.Headers["User-Agent"])
+}
+
+func TestWithDefaultHeaders_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers map[string]string
+	}{
+		{name: "empty name", headers: map[string]string{"": "value"}},
+		{name: "name with space", headers: map[string]string{"X Request": "value"}},
+		{name: "value with newline", headers: map[string]string{"X-Request-ID": "a\r\nX-Injected: 1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClient("https://127.0.0.1:1234/", "secret", WithDefaultHeaders(tt.headers))
+
+			// Assert
+			assert.Nil(t, client)
+			assert.ErrorIs(t, err, InvalidOptionError)
+			assert.Contains(t, err.Error(), "option: WithDefaultHeaders")
+		})
+	}
+}
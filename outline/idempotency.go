@@ -0,0 +1,25 @@
+package outline
+
+import "context"
+
+// idempotencyKeyHeader is the header carrying the key set by [ContextWithIdempotencyKey].
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// idempotencyKey is the context key of the key set by [ContextWithIdempotencyKey].
+type idempotencyKey struct{}
+
+// ContextWithIdempotencyKey returns a copy of ctx carrying an idempotency key.
+// [Client.CreateAccessKey] calls made with the context send it in the Idempotency-Key header,
+// so that a server honoring the header creates the key at most once; see [WithRetryCreateOnIdempotencyKey].
+// An empty key is ignored.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// withIdempotencyKey adds the idempotency key carried by ctx, if any, to headers.
+func withIdempotencyKey(ctx context.Context, headers Headers) Headers {
+	if key, ok := ctx.Value(idempotencyKey{}).(string); ok && key != "" {
+		headers[idempotencyKeyHeader] = key
+	}
+	return headers
+}
@@ -0,0 +1,68 @@
+package outline
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// keyQuota tracks the number of access keys on the server for [WithMaxKeys].
+type keyQuota struct {
+	mu    sync.Mutex
+	known bool
+	count int
+}
+
+// withKeySlot runs create if the server may hold another access key under [WithMaxKeys].
+// The key is counted before create runs, so concurrent creations cannot exceed the limit,
+// and uncounted again if create fails. operation names the creation in the quota error.
+func (c *Client) withKeySlot(
+	ctx context.Context, operation string, create func() (*types.AccessKey, error),
+) (*types.AccessKey, error) {
+	if c.maxKeys == 0 {
+		return create()
+	}
+
+	if err := c.keyQuota.reserve(ctx, c, operation); err != nil {
+		return nil, err
+	}
+	key, err := create()
+	if err != nil {
+		c.keyQuota.release()
+	}
+	return key, err
+}
+
+// reserve counts one more key, reading the current number of keys from the server on first use.
+// It fails with [*ClientError] wrapping [KeyQuotaExceededError] if the limit of c has been reached.
+func (q *keyQuota) reserve(ctx context.Context, c *Client, operation string) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.known {
+		keys, err := c.GetAccessKeys(ctx)
+		if err != nil {
+			return err
+		}
+		q.count = len(keys)
+		q.known = true
+	}
+
+	if q.count >= c.maxKeys {
+		return c.finishClientError(ctx, errKeyQuotaExceeded(operation, q.count, c.maxKeys))
+	}
+	q.count++
+	return nil
+}
+
+// release counts one key less, after a failed creation or a deletion.
+// It does nothing until the number of keys has been read.
+func (q *keyQuota) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.known && q.count > 0 {
+		q.count--
+	}
+}
@@ -0,0 +1,127 @@
+package outline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithMaxKeys Tests ===
+
+func TestWithMaxKeys_CreateAccessKey(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxKeys       int
+		expectedErrs  []error
+		expectedCalls []string
+	}{
+		{
+			name:          "key beyond limit refused",
+			maxKeys:       3,
+			expectedErrs:  []error{nil, KeyQuotaExceededError},
+			expectedCalls: []string{"GET /access-keys", "POST /access-keys"},
+		},
+		{
+			name:          "guard disabled",
+			maxKeys:       0,
+			expectedErrs:  []error{nil, nil},
+			expectedCalls: []string{"POST /access-keys", "POST /access-keys"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			server := &fakeServer{keys: []*types.AccessKey{{ID: "a"}, {ID: "b"}}}
+			client := MustNewClient("http://localhost:8081/api/", "",
+				WithClient(newRoutingMockDoer(t, &calls, server.handle)), WithMaxKeys(tt.maxKeys))
+			ctx := context.Background()
+
+			// Act
+			errs := make([]error, 0, len(tt.expectedErrs))
+			for range tt.expectedErrs {
+				_, err := client.CreateAccessKey(ctx, &types.CreateAccessKey{Method: "aes-128-gcm"})
+				errs = append(errs, err)
+			}
+
+			// Assert
+			for i, err := range errs {
+				if tt.expectedErrs[i] == nil {
+					assert.NoError(t, err)
+					continue
+				}
+				assert.ErrorIs(t, err, tt.expectedErrs[i])
+				assert.ErrorIs(t, err, ClientOutlineError)
+			}
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestWithMaxKeys_DeleteFreesSlot(t *testing.T) {
+	// Arrange
+	server := &fakeServer{}
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(newRoutingMockDoer(t, nil, server.handle)), WithMaxKeys(1))
+	ctx := context.Background()
+
+	// Act
+	created, firstErr := client.CreateAccessKey(ctx, &types.CreateAccessKey{Method: "aes-128-gcm"})
+	_, refusedErr := client.CreateAccessKeyWithID(ctx, "custom", &types.CreateAccessKey{Method: "aes-128-gcm"})
+	require.NoError(t, firstErr)
+	deleteErr := client.DeleteAccessKey(ctx, created.ID)
+	_, secondErr := client.CreateAccessKeyWithID(ctx, "custom", &types.CreateAccessKey{Method: "aes-128-gcm"})
+
+	// Assert
+	assert.ErrorIs(t, refusedErr, KeyQuotaExceededError)
+	var clientErr *ClientError
+	require.ErrorAs(t, refusedErr, &clientErr)
+	assert.Equal(t, opCreateAccessKeyWithID, clientErr.Operation())
+	assert.Zero(t, clientErr.StatusCode())
+	assert.Contains(t, refusedErr.Error(), "access keys: 1, limit: 1")
+	require.NoError(t, deleteErr)
+	assert.NoError(t, secondErr)
+}
+
+func TestWithMaxKeys_BatchCreateAccessKeys(t *testing.T) {
+	// Arrange
+	server := &fakeServer{keys: []*types.AccessKey{{ID: "a"}}}
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(newRoutingMockDoer(t, nil, server.handle)), WithMaxKeys(4))
+	reqs := make([]*types.CreateAccessKey, 6)
+	for i := range reqs {
+		reqs[i] = &types.CreateAccessKey{Method: "aes-128-gcm"}
+	}
+
+	// Act
+	keys, errs := client.BatchCreateAccessKeys(context.Background(), reqs, 3)
+
+	// Assert
+	created, refused := 0, 0
+	for i, err := range errs {
+		if err == nil {
+			require.NotNil(t, keys[i])
+			created++
+			continue
+		}
+		assert.ErrorIs(t, err, KeyQuotaExceededError)
+		refused++
+	}
+	assert.Equal(t, 3, created)
+	assert.Equal(t, 3, refused)
+	assert.Len(t, server.keys, 4)
+}
+
+func TestWithMaxKeys_NegativeRejected(t *testing.T) {
+	// Act
+	client, err := NewClient("http://localhost:8081/api/", "", WithMaxKeys(-1))
+
+	// Assert
+	assert.Nil(t, client)
+	assert.ErrorIs(t, err, InvalidOptionError)
+	assert.Contains(t, err.Error(), "option: WithMaxKeys")
+}
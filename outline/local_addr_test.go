@@ -0,0 +1,94 @@
+package outline
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithLocalAddr Tests ===
+
+func TestWithLocalAddr(t *testing.T) {
+	// Arrange
+	if l, err := net.Listen("tcp", "127.0.0.2:0"); err != nil {
+		t.Skipf("loopback address 127.0.0.2 is not usable: %v", err)
+	} else {
+		_ = l.Close()
+	}
+	cert := testutil.NewSelfSignedCert(t, time.Now().Add(time.Hour))
+	srv := testutil.NewTLSServer(t, cert, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The server name reports the source address of the connection.
+		remoteHost, _, _ := net.SplitHostPort(r.RemoteAddr)
+		_, _ = w.Write([]byte(`{"name":"` + remoteHost + `"}`))
+	}))
+	client, err := NewClient(srv.URL, "secret", WithInsecureSkipVerify(true), WithLocalAddr("127.0.0.2"))
+	require.NoError(t, err)
+
+	// Act
+	info, err := client.GetServerInfo(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.2", info.Name)
+}
+
+func TestParseLocalAddr(t *testing.T) {
+	tests := []struct {
+		name        string
+		addr        string
+		expected    string
+		expectedErr bool
+	}{
+		{name: "IPv4", addr: "192.0.2.10", expected: "192.0.2.10:0"},
+		{name: "IPv4 with port", addr: "192.0.2.10:4000", expected: "192.0.2.10:4000"},
+		{name: "IPv6", addr: "2001:db8::10", expected: "[2001:db8::10]:0"},
+		{name: "IPv6 with port", addr: "[2001:db8::10]:0", expected: "[2001:db8::10]:0"},
+		{name: "host name", addr: "example.com", expectedErr: true},
+		{name: "empty", addr: "", expectedErr: true},
+		{name: "invalid port", addr: "192.0.2.10:http", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			addr, err := parseLocalAddr(tt.addr)
+
+			// Assert
+			if tt.expectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, addr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, addr.String())
+		})
+	}
+}
+
+func TestWithLocalAddr_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+	}{
+		{name: "host name", options: []Option{WithLocalAddr("example.com")}},
+		{name: "custom transport", options: []Option{WithClient(NewMockDoer(t)), WithLocalAddr("192.0.2.10")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClient("https://127.0.0.1:1234/", "secret", tt.options...)
+
+			// Assert
+			assert.Nil(t, client)
+			assert.ErrorIs(t, err, InvalidOptionError)
+			assert.Contains(t, err.Error(), "option: WithLocalAddr")
+		})
+	}
+}
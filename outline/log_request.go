@@ -2,23 +2,38 @@ package outline
 
 import (
 	"context"
+	"log/slog"
+	"net/textproto"
+	"slices"
 
 	"github.com/nepriyatelev/outline-client-go/internal/contracts"
 )
 
 // logRequest formats and sends two messages: Info and Debug.
+// A [StructuredLogger] receives a single Info record with the request details as attributes instead,
+// with the secret masked in the URL. The values of credential headers are redacted in every message.
 // methodName — the name of the calling client function, e.g. "GetExperimentalMetrics".
 // req — the final HTTP request.
 func (c *Client) logRequest(ctx context.Context, methodName string, req *contracts.Request) {
 	// Mask the secret in the Info log
 	maskedURL := maskSecretPath(req.URL, c.secret)
+	headers := redactHeaders(req.Headers)
+	if sl, ok := c.logger.(contracts.StructuredLogger); ok {
+		sl.InfoAttrs(ctx, "sending request",
+			slog.String("operation", methodName),
+			slog.String("method", req.Method),
+			slog.String("url", maskedURL),
+			slog.Any("headers", headers),
+		)
+		return
+	}
 	c.logger.Infof(
 		ctx,
 		"%s: sending request: method=%s url=%s headers=%v",
 		methodName,
 		req.Method,
 		maskedURL,
-		req.Headers,
+		headers,
 	)
 	// In the debug log, show the full URL
 	c.logger.Debugf(
@@ -27,6 +42,22 @@ func (c *Client) logRequest(ctx context.Context, methodName string, req *contrac
 		methodName,
 		req.Method,
 		req.URL,
-		req.Headers,
+		headers,
 	)
 }
+
+// redactedHeaders lists the canonical names of the headers that carry credentials,
+// such as an Authorization header set by [WithDefaultHeaders].
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+
+// redactHeaders returns a copy of headers with the values of [redactedHeaders] replaced by *****.
+func redactHeaders(headers map[string]string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for name, value := range headers {
+		if slices.Contains(redactedHeaders, textproto.CanonicalMIMEHeaderKey(name)) {
+			value = "*****"
+		}
+		redacted[name] = value
+	}
+	return redacted
+}
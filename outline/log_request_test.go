@@ -0,0 +1,124 @@
+package outline
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithSlogLogger Tests ===
+
+func TestWithSlogLogger_LogsRequestAttributes(t *testing.T) {
+	// Arrange
+	const secret = "AbC123sEcReT"
+	var buf bytes.Buffer
+	handler := slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusNoContent}, nil, nil)
+	client := MustNewClient("http://localhost:8081/api/", secret, WithClient(mockDoer), WithSlogLogger(slog.New(handler)))
+
+	// Act
+	err := client.DeleteAccessKey(context.Background(), "1")
+
+	// Assert
+	require.NoError(t, err)
+	var records []map[string]any
+	for line := range strings.Lines(buf.String()) {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		if record["msg"] == "sending request" {
+			records = append(records, record)
+		}
+	}
+	require.Len(t, records, 1)
+	info := records[0]
+	assert.Equal(t, "INFO", info["level"])
+	assert.Equal(t, "DeleteAccessKey", info["operation"])
+	assert.Equal(t, http.MethodDelete, info["method"])
+	assert.Equal(t, "http://localhost:8081/api/*****/access-keys/1", info["url"])
+	assert.Contains(t, info["headers"], "Content-Type")
+	assert.NotContains(t, buf.String(), secret)
+}
+
+func TestLogRequest_RedactsCredentialHeaders(t *testing.T) {
+	const token = "Bearer s3cr3t-t0k3n"
+	headers := map[string]string{
+		"Authorization":       token,
+		"proxy-authorization": token,
+		"Cookie":              token,
+		"X-Request-ID":        "req-1",
+	}
+
+	t.Run("formatted logger", func(t *testing.T) {
+		// Arrange
+		logger := &recordingLogger{}
+		mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusNoContent}, nil, nil)
+		client := MustNewClient("http://localhost:8081/api/", "",
+			WithClient(mockDoer), WithLogger(logger), WithDefaultHeaders(headers))
+
+		// Act
+		err := client.DeleteAccessKey(context.Background(), "1")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, 1, logger.countContaining("sending request"))
+		assert.Equal(t, 0, logger.countContaining(token))
+		assert.Equal(t, 1, logger.countContaining("Authorization:*****"))
+		assert.Equal(t, 1, logger.countContaining("X-Request-Id:req-1"))
+	})
+
+	t.Run("structured logger", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		handler := slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})
+		mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusNoContent}, nil, nil)
+		client := MustNewClient("http://localhost:8081/api/", "",
+			WithClient(mockDoer), WithSlogLogger(slog.New(handler)), WithDefaultHeaders(headers))
+
+		// Act
+		err := client.DeleteAccessKey(context.Background(), "1")
+
+		// Assert
+		require.NoError(t, err)
+		assert.NotContains(t, buf.String(), token)
+		assert.Contains(t, buf.String(), `"Authorization":"*****"`)
+		assert.Contains(t, buf.String(), `"Proxy-Authorization":"*****"`)
+		assert.Contains(t, buf.String(), `"Cookie":"*****"`)
+		assert.Contains(t, buf.String(), `"X-Request-Id":"req-1"`)
+	})
+}
+
+func TestWithSlogLogger_DefaultLevel(t *testing.T) {
+	// Arrange
+	const secret = "AbC123sEcReT"
+	var buf bytes.Buffer
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusNoContent}, nil, nil)
+	client := MustNewClient("http://localhost:8081/api/", secret,
+		WithClient(mockDoer), WithSlogLogger(slog.New(slog.NewJSONHandler(&buf, nil))))
+
+	// Act
+	err := client.DeleteAccessKey(context.Background(), "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `"url":"http://localhost:8081/api/*****/access-keys/1"`)
+	assert.NotContains(t, buf.String(), secret)
+}
+
+func TestWithSlogLogger_Nil(t *testing.T) {
+	// Arrange
+	client := MustNewClient("http://localhost:8081/api/", "", WithSlogLogger(nil))
+
+	// Act
+	_, structured := client.logger.(contracts.StructuredLogger)
+
+	// Assert
+	assert.False(t, structured)
+}
@@ -3,6 +3,8 @@ package outline
 import (
 	"context"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/nepriyatelev/outline-client-go/internal/contracts"
 	"github.com/nepriyatelev/outline-client-go/outline/types"
@@ -10,7 +12,16 @@ import (
 
 // === Transfer Metrics ===
 
+// GetMetricsTransfer retrieves the number of bytes transferred by each access key,
+// keyed by access key ID. A server without traffic yields an empty map.
+//
+// It returns [*ClientError] for unexpected HTTP status codes,
+// [*UnmarshalError] if JSON parsing fails,
+// or [*DoError] if the HTTP request fails.
 func (c *Client) GetMetricsTransfer(ctx context.Context) (*types.MetricsTransfer, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	req := &contracts.Request{
 		Method:  http.MethodGet,
 		URL:     c.getMetricsTransferPath.String(),
@@ -18,17 +29,49 @@ func (c *Client) GetMetricsTransfer(ctx context.Context) (*types.MetricsTransfer
 		Body:    nil,
 	}
 
-	c.logRequest(ctx, "GetMetricsTransfer", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "GetMetricsTransfer", req)
 	if err != nil {
 		return nil, errDoGetMetricsTransfer(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return unmarshalJSONWithError[types.MetricsTransfer](resp.Body)
+		return unmarshalJSONWithError[types.MetricsTransfer](resp.Body, c.unmarshalOptions()...)
 	default:
-		return nil, errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return nil, c.finishClientError(ctx, errStatusCode(opGetMetricsTransfer, resp.StatusCode, resp.Body))
+	}
+}
+
+// GetServerMetricsSummary retrieves the transfer metrics and the experimental metrics collected
+// over the since window at the same time. Each request runs with its own timeout (see [WithTimeout]),
+// so a slow experimental endpoint does not delay or cancel the transfer request.
+//
+// If only the experimental metrics fail, e.g. because the request timed out or the server does not
+// provide the endpoint, it returns the summary with the transfer metrics and a nil Experimental field
+// together with [*PartialMetricsError] wrapping [PartialMetricsFailedError] and the experimental error.
+// If the transfer metrics fail, it returns nil and the errors of [Client.GetMetricsTransfer].
+func (c *Client) GetServerMetricsSummary(ctx context.Context, since time.Duration) (*types.ServerMetricsSummary, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	var (
+		wg              sync.WaitGroup
+		experimental    *types.ExperimentalMetricsResponse
+		experimentalErr error
+	)
+	wg.Go(func() {
+		experimental, experimentalErr = c.GetExperimentalMetrics(ctx, since)
+	})
+
+	transfer, transferErr := c.GetMetricsTransfer(ctx)
+	wg.Wait()
+
+	if transferErr != nil {
+		return nil, transferErr
+	}
+	summary := &types.ServerMetricsSummary{Transfer: transfer, Experimental: experimental}
+	if experimentalErr != nil {
+		return summary, errPartialMetrics("experimental", experimentalErr)
 	}
+	return summary, nil
 }
@@ -0,0 +1,209 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// === GetMetricsTransfer Tests ===
+
+func TestGetMetricsTransfer_Success(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected map[string]int64
+	}{
+		{
+			name:     "populated",
+			body:     `{"bytesTransferredByUserId":{"0":1024,"1":0,"7":123456789}}`,
+			expected: map[string]int64{"0": 1024, "1": 0, "7": 123456789},
+		},
+		{
+			name:     "empty",
+			body:     `{"bytesTransferredByUserId":{}}`,
+			expected: map[string]int64{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var captured *contracts.Request
+			mockDoer := newMockDoer(t, &contracts.Response{
+				StatusCode: http.StatusOK,
+				Body:       []byte(tt.body),
+			}, nil, &captured)
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			result, err := client.GetMetricsTransfer(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tt.expected, result.BytesTransferredByUserID)
+			require.NotNil(t, captured)
+			assert.Equal(t, http.MethodGet, captured.Method)
+			assert.Equal(t, "/metrics/transfer", requestPath(captured))
+		})
+	}
+}
+
+func TestGetMetricsTransfer_Errors(t *testing.T) {
+	networkError := errors.New("network error")
+
+	tests := []struct {
+		name        string
+		resp        *contracts.Response
+		doErr       error
+		expectedErr error
+	}{
+		{name: "doer error", doErr: networkError, expectedErr: DoOperationError},
+		{
+			name:        "invalid json",
+			resp:        &contracts.Response{StatusCode: http.StatusOK, Body: []byte("invalid json")},
+			expectedErr: UnmarshalFailedError,
+		},
+		{
+			name:        "empty body",
+			resp:        &contracts.Response{StatusCode: http.StatusOK},
+			expectedErr: UnmarshalEmptyBodyError,
+		},
+		{
+			name:        "unexpected status",
+			resp:        &contracts.Response{StatusCode: http.StatusInternalServerError},
+			expectedErr: UnexpectedStatusCodeError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoer(t, tt.resp, tt.doErr, nil)
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			result, err := client.GetMetricsTransfer(context.Background())
+
+			// Assert
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, ClientOutlineError)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestGetMetricsTransfer_LogsRequest(t *testing.T) {
+	// Arrange
+	audit := &bufferedAuditWriter{}
+	mockDoer := newMockDoer(t, &contracts.Response{
+		StatusCode: http.StatusOK,
+		Body:       []byte(`{"bytesTransferredByUserId":{}}`),
+	}, nil, nil)
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithLogger(audit))
+
+	// Act
+	_, err := client.GetMetricsTransfer(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Contains(t, audit.buf.String(), "GetMetricsTransfer: sending request: method=GET")
+}
+
+// === GetServerMetricsSummary Tests ===
+
+// newMetricsSummaryMockDoer answers the transfer and experimental metrics requests with the given
+// statuses. A zero experimental status blocks the experimental request until its context is done.
+func newMetricsSummaryMockDoer(t *testing.T, transferStatus, experimentalStatus int) *MockDoer {
+	m := NewMockDoer(t)
+	m.On("Do", mock.Anything, mock.AnythingOfType("*contracts.Request")).
+		Return(func(ctx context.Context, req *contracts.Request) (*contracts.Response, error) {
+			if requestPath(req) == "/metrics/transfer" {
+				return &contracts.Response{
+					StatusCode: transferStatus,
+					Body:       []byte(`{"bytesTransferredByUserId":{"1":100}}`),
+				}, nil
+			}
+			if experimentalStatus == 0 {
+				<-ctx.Done()
+				return nil, ctx.Err()
+			}
+			return &contracts.Response{
+				StatusCode: experimentalStatus,
+				Body:       []byte(`{"server":{"locations":[{"location":"US"}]},"accessKeys":[]}`),
+			}, nil
+		})
+	return m
+}
+
+func TestGetServerMetricsSummary(t *testing.T) {
+	// Arrange
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(newMetricsSummaryMockDoer(t, http.StatusOK, http.StatusOK)))
+
+	// Act
+	summary, err := client.GetServerMetricsSummary(context.Background(), time.Hour)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"1": 100}, summary.Transfer.BytesTransferredByUserID)
+	require.NotNil(t, summary.Experimental)
+	require.Len(t, summary.Experimental.Server.Locations, 1)
+	assert.Equal(t, "US", summary.Experimental.Server.Locations[0].Location)
+}
+
+func TestGetServerMetricsSummary_ExperimentalFails(t *testing.T) {
+	tests := []struct {
+		name               string
+		experimentalStatus int
+		expectedErr        error
+	}{
+		{name: "timeout", expectedErr: context.DeadlineExceeded},
+		{name: "unsupported", experimentalStatus: http.StatusNotFound, expectedErr: ExperimentalMetricsUnsupportedError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMetricsSummaryMockDoer(t, http.StatusOK, tt.experimentalStatus)
+			client := MustNewClient("http://localhost:8081/api/", "",
+				WithClient(mockDoer), WithTimeout(20*time.Millisecond))
+
+			// Act
+			summary, err := client.GetServerMetricsSummary(context.Background(), time.Hour)
+
+			// Assert
+			var partialErr *PartialMetricsError
+			require.ErrorAs(t, err, &partialErr)
+			assert.Equal(t, "experimental", partialErr.Part())
+			assert.ErrorIs(t, err, PartialMetricsFailedError)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			require.NotNil(t, summary)
+			assert.Equal(t, map[string]int64{"1": 100}, summary.Transfer.BytesTransferredByUserID)
+			assert.Nil(t, summary.Experimental)
+		})
+	}
+}
+
+func TestGetServerMetricsSummary_TransferFails(t *testing.T) {
+	// Arrange
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(newMetricsSummaryMockDoer(t, http.StatusInternalServerError, http.StatusOK)))
+
+	// Act
+	summary, err := client.GetServerMetricsSummary(context.Background(), time.Hour)
+
+	// Assert
+	assert.Nil(t, summary)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+	var partialErr *PartialMetricsError
+	assert.NotErrorAs(t, err, &partialErr)
+}
@@ -0,0 +1,47 @@
+package outline
+
+import (
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+)
+
+// RequestInfo describes one round trip through the [Doer], as reported to [WithRequestObserver].
+type RequestInfo struct {
+	// MethodName is the name of the client method that sent the request, e.g. "CreateAccessKey".
+	MethodName string
+	// HTTPMethod is the HTTP method of the request.
+	HTTPMethod string
+	// URL is the request URL with the secret masked.
+	URL string
+	// Attempt is the number of the attempt, starting at 1; it exceeds 1 only for retries made by [WithRetry].
+	Attempt int
+	// StatusCode is the status code of the response, or zero if no response was received.
+	StatusCode int
+	// Duration is the time spent in [Doer.Do].
+	Duration time.Duration
+	// Err is the error returned by [Doer.Do], if any, with the secret masked in its message.
+	Err error
+}
+
+// observeRequest reports a round trip to the observer set by [WithRequestObserver].
+func (c *Client) observeRequest(
+	methodName string, req *contracts.Request, attempt int, resp *contracts.Response, err error, duration time.Duration,
+) {
+	if c.observer == nil {
+		return
+	}
+
+	info := RequestInfo{
+		MethodName: methodName,
+		HTTPMethod: req.Method,
+		URL:        maskSecretPath(req.URL, c.secret),
+		Attempt:    attempt,
+		Duration:   duration,
+		Err:        c.maskErrorSecret(err),
+	}
+	if resp != nil {
+		info.StatusCode = resp.StatusCode
+	}
+	c.observer(info)
+}
@@ -0,0 +1,115 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithRequestObserver Tests ===
+
+func TestWithRequestObserver(t *testing.T) {
+	const secret = "AbC123sEcReT"
+	networkErr := errors.New("network error")
+
+	tests := []struct {
+		name               string
+		resp               *contracts.Response
+		err                error
+		expectedStatusCode int
+	}{
+		{name: "success", resp: jsonResponse(http.StatusOK, types.AccessKey{ID: "1"}), expectedStatusCode: http.StatusOK},
+		{name: "error status", resp: &contracts.Response{StatusCode: http.StatusNotFound}, expectedStatusCode: http.StatusNotFound},
+		{name: "doer error", err: networkErr},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var infos []RequestInfo
+			mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+				time.Sleep(time.Millisecond)
+				return tt.resp, tt.err
+			})
+			client := MustNewClient("http://localhost:8081/api/", secret, WithClient(mockDoer),
+				WithRequestObserver(func(info RequestInfo) { infos = append(infos, info) }))
+
+			// Act
+			_, _ = client.GetAccessKey(context.Background(), "1")
+
+			// Assert
+			require.Len(t, infos, 1)
+			info := infos[0]
+			assert.Equal(t, "GetAccessKey", info.MethodName)
+			assert.Equal(t, http.MethodGet, info.HTTPMethod)
+			assert.Equal(t, "http://localhost:8081/api/*****/access-keys/1", info.URL)
+			assert.Equal(t, 1, info.Attempt)
+			assert.Equal(t, tt.expectedStatusCode, info.StatusCode)
+			assert.GreaterOrEqual(t, info.Duration, time.Millisecond)
+			assert.ErrorIs(t, info.Err, tt.err)
+		})
+	}
+}
+
+func TestWithRequestObserver_MasksErrorSecret(t *testing.T) {
+	// Arrange
+	const secret = "AbC123sEcReT"
+	doerErr := errors.New(`Get "http://localhost:8081/api/` + secret + `/access-keys/1": connection refused`)
+	var infos []RequestInfo
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return nil, doerErr
+	})
+	client := MustNewClient("http://localhost:8081/api/", secret, WithClient(mockDoer),
+		WithRequestObserver(func(info RequestInfo) { infos = append(infos, info) }))
+
+	// Act
+	_, _ = client.GetAccessKey(context.Background(), "1")
+
+	// Assert
+	require.Len(t, infos, 1)
+	assert.ErrorIs(t, infos[0].Err, doerErr)
+	assert.NotContains(t, infos[0].Err.Error(), secret)
+	assert.Contains(t, infos[0].Err.Error(), "/api/*****/access-keys/1")
+}
+
+func TestWithRequestObserver_EveryAttempt(t *testing.T) {
+	// Arrange
+	var infos []RequestInfo
+	mockDoer := newSequenceMockDoer(t, nil,
+		&contracts.Response{StatusCode: http.StatusServiceUnavailable},
+		jsonResponse(http.StatusOK, types.AccessKey{ID: "1"}),
+	)
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithRetry(3, 0),
+		WithRequestObserver(func(info RequestInfo) { infos = append(infos, info) }))
+
+	// Act
+	_, err := client.GetAccessKey(context.Background(), "1")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+	assert.Equal(t, 1, infos[0].Attempt)
+	assert.Equal(t, http.StatusServiceUnavailable, infos[0].StatusCode)
+	assert.Equal(t, 2, infos[1].Attempt)
+	assert.Equal(t, http.StatusOK, infos[1].StatusCode)
+}
+
+func TestWithRequestObserver_Nil(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusNoContent}, nil, nil)
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithRequestObserver(nil))
+
+	// Act
+	err := client.DeleteAccessKey(context.Background(), "1")
+
+	// Assert
+	require.NoError(t, err)
+	assert.Nil(t, client.observer)
+}
@@ -0,0 +1,29 @@
+package outline
+
+// Operation names reported by [DoError.Operation] and [ClientError.Operation].
+const (
+	opGetServerInfo            = "get server info"
+	opCheckAccessKeyPortOpen   = "check access key port open"
+	opDetectClockSkew          = "detect clock skew"
+	opUpdateServerHostname     = "update server hostname"
+	opUpdatePortNewAccessKeys  = "update port for new access keys"
+	opUpdateServerName         = "update server name"
+	opGetMetricsEnabled        = "get metrics enabled"
+	opUpdateMetricsEnabled     = "update metrics enabled"
+	opUpdateKeyLimitBytes      = "update key limit bytes"
+	opDeleteKeyLimitBytes      = "delete key limit bytes"
+	opCreateAccessKey          = "create access key"
+	opCreateAccessKeyWithID    = "create access key with id"
+	opGetAccessKeys            = "get access keys"
+	opGetAccessKeysFields      = "get access keys fields"
+	opGetAccessKey             = "get access key"
+	opGetAccessKeyByName       = "get access key by name"
+	opGetAccessKeyPublicURL    = "get access key public url"
+	opUpdateAccessKey          = "update access key"
+	opDeleteAccessKey          = "delete access key"
+	opUpdateNameAccessKey      = "update name access key"
+	opUpdateDataLimitAccessKey = "update data limit access key"
+	opDeleteDataLimitAccessKey = "delete data limit access key"
+	opGetMetricsTransfer       = "get metrics transfer"
+	opGetExperimentalMetrics   = "get experimental metrics"
+)
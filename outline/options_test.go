@@ -0,0 +1,99 @@
+package outline
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithDefaultPort Tests ===
+
+func TestWithDefaultPort_CreateAccessKey(t *testing.T) {
+	tests := []struct {
+		name         string
+		requestPort  uint16
+		expectedPort uint16
+	}{
+		{
+			name:         "unset port is filled in",
+			requestPort:  0,
+			expectedPort: 9443,
+		},
+		{
+			name:         "explicit port wins",
+			requestPort:  8388,
+			expectedPort: 8388,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var capturedReq *contracts.Request
+			mockDoer := newMockDoerAccessKey(t, jsonResponse(http.StatusCreated, types.AccessKey{ID: "1"}), nil, &capturedReq)
+			client := MustNewClient("http://localhost:8081/api/", "", WithDefaultPort(9443), WithClient(mockDoer))
+			createAccessKey := &types.CreateAccessKey{Method: types.MethodAES128GCM, Port: tt.requestPort}
+
+			// Act
+			_, err := client.CreateAccessKey(context.Background(), createAccessKey)
+
+			// Assert
+			require.NoError(t, err)
+			var sentBody types.CreateAccessKey
+			require.NoError(t, json.Unmarshal(capturedReq.Body, &sentBody))
+			assert.Equal(t, tt.expectedPort, sentBody.Port)
+			assert.Equal(t, tt.requestPort, createAccessKey.Port, "caller's request must not be modified")
+		})
+	}
+}
+
+func TestWithDefaultPort_CreateAccessKeyWithID(t *testing.T) {
+	// Arrange
+	var capturedReq *contracts.Request
+	mockDoer := newMockDoerAccessKey(t, jsonResponse(http.StatusCreated, types.AccessKey{ID: "1"}), nil, &capturedReq)
+	client := MustNewClient("http://localhost:8081/api/", "", WithDefaultPort(9443), WithClient(mockDoer))
+
+	// Act
+	_, err := client.CreateAccessKeyWithID(context.Background(), "1", &types.CreateAccessKey{Method: types.MethodAES128GCM})
+
+	// Assert
+	require.NoError(t, err)
+	var sentBody types.CreateAccessKey
+	require.NoError(t, json.Unmarshal(capturedReq.Body, &sentBody))
+	assert.Equal(t, uint16(9443), sentBody.Port)
+}
+
+func TestWithDefaultPort_ZeroPort(t *testing.T) {
+	// Act
+	client, err := NewClient("http://localhost:8081/api/", "", WithDefaultPort(0))
+
+	// Assert
+	assert.Nil(t, client)
+	var optErr *OptionError
+	require.ErrorAs(t, err, &optErr)
+	assert.Equal(t, "WithDefaultPort", optErr.option)
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, InvalidOptionError)
+	assert.ErrorIs(t, err, InvalidPortError)
+	assert.Panics(t, func() { MustNewClient("http://localhost:8081/api/", "", WithDefaultPort(0)) })
+}
+
+func TestWithClock(t *testing.T) {
+	// Arrange
+	fixed := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// Act
+	withClock := MustNewClient("http://localhost:8081/api/", "", WithClock(func() time.Time { return fixed }))
+	withNil := MustNewClient("http://localhost:8081/api/", "", WithClock(nil))
+
+	// Assert
+	assert.Equal(t, fixed, withClock.now())
+	assert.WithinDuration(t, time.Now(), withNil.now(), time.Minute)
+}
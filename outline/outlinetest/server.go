@@ -0,0 +1,554 @@
+// Package outlinetest provides an in-memory emulation of the Outline server management API
+// for tests of code built on the outline client.
+package outlinetest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/outline"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// DefaultSecret is the secret the API of a [MockServer] is served under.
+const DefaultSecret = "mock-secret"
+
+// Request is a request recorded by a [MockServer].
+type Request struct {
+	Method string // Method is the HTTP method, e.g. "POST".
+	Path   string // Path is the request path without the secret, e.g. "/access-keys/1".
+	Body   []byte // Body is the request body, or nil if the request had none.
+}
+
+// MockServer emulates the Outline server management API with in-memory state:
+// the server information and configuration, access keys, data limits and transfer metrics.
+// The routes answer like the real server: 201 on create, 204 on changes without a response body,
+// 400 on invalid input, 404 on unknown access keys and paths, with {"code","message"} error bodies.
+// The experimental metrics endpoint is not emulated and answers 404, as older servers do.
+//
+// PUT /access-keys/{id} creates a key with the ID and answers 409 if it exists, whatever the body holds:
+// like the real server, the mock never replaces an existing key.
+//
+// MockServer is safe for concurrent use. Close it when done.
+type MockServer struct {
+	// URL is the base URL of the server, to be passed to [outline.NewClient] together with Secret.
+	URL string
+	// Secret is the secret path segment the API is served under.
+	Secret string
+
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	info     types.ServerInfoResponse
+	keys     map[string]*types.AccessKey
+	order    []string // order holds the access key IDs in creation order.
+	nextID   int
+	transfer map[string]int64
+	requests []Request
+}
+
+// NewMockServer starts a [MockServer] without access keys, serving the API under [DefaultSecret].
+func NewMockServer() *MockServer {
+	s := &MockServer{
+		Secret: DefaultSecret,
+		info: types.ServerInfoResponse{
+			Name:                  "Outline Server",
+			ServerID:              "mock-server",
+			CreatedTimestampMs:    1700000000000,
+			Version:               "1.9.0",
+			PortForNewAccessKeys:  8388,
+			HostnameForAccessKeys: "127.0.0.1",
+		},
+		keys:     make(map[string]*types.AccessKey),
+		transfer: make(map[string]int64),
+	}
+	s.srv = httptest.NewServer(s.routes())
+	s.URL = s.srv.URL
+
+	return s
+}
+
+// Close shuts the server down.
+func (s *MockServer) Close() {
+	s.srv.Close()
+}
+
+// NewClient returns a client targeting the server with the given options.
+func (s *MockServer) NewClient(options ...outline.Option) (*outline.Client, error) {
+	return outline.NewClient(s.URL, s.Secret, options...)
+}
+
+// SeedAccessKeys adds the keys to the server as if they had been created. A key without an ID gets
+// the next free one, a key without a password, port or method the defaults of a created key.
+// A key with the ID of an existing one replaces it.
+func (s *MockServer) SeedAccessKeys(keys ...types.AccessKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range keys {
+		s.storeKey(&key)
+	}
+}
+
+// AccessKeys returns a copy of the access keys of the server in creation order.
+func (s *MockServer) AccessKeys() []types.AccessKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]types.AccessKey, 0, len(s.order))
+	for _, id := range s.order {
+		keys = append(keys, s.withAccessURL(s.keys[id]))
+	}
+	return keys
+}
+
+// ServerInfo returns the current server information.
+func (s *MockServer) ServerInfo() types.ServerInfoResponse {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.info
+}
+
+// SetServerInfo replaces the server information, e.g. to emulate another server version.
+func (s *MockServer) SetServerInfo(info types.ServerInfoResponse) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.info = info
+}
+
+// SetBytesTransferred sets the bytes transferred by the access key with the ID,
+// as reported by the transfer metrics.
+func (s *MockServer) SetBytesTransferred(accessKeyID string, bytes int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.transfer[accessKeyID] = bytes
+}
+
+// Requests returns the requests received so far, in order.
+func (s *MockServer) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.requests)
+}
+
+// AssertRequested reports whether a request with the method and path, given without the secret,
+// e.g. "/access-keys/1", was received, and marks the test as failed if not.
+func (s *MockServer) AssertRequested(t testing.TB, method, path string) bool {
+	t.Helper()
+
+	requests := s.Requests()
+	for _, req := range requests {
+		if req.Method == method && req.Path == path {
+			return true
+		}
+	}
+	t.Errorf("outlinetest: no %s %s request received; received: %v", method, path, requests)
+	return false
+}
+
+// routes registers the API endpoints under the secret.
+func (s *MockServer) routes() http.Handler {
+	prefix := "/" + s.Secret
+	mux := http.NewServeMux()
+	handle := func(pattern string, h func(w http.ResponseWriter, r *http.Request)) {
+		method, path, _ := strings.Cut(pattern, " ")
+		mux.HandleFunc(method+" "+prefix+path, h)
+	}
+
+	handle("GET /server", s.getServer)
+	handle("PUT /server/hostname-for-access-keys", s.putHostname)
+	handle("PUT /server/port-for-new-access-keys", s.putPort)
+	handle("PUT /server/access-key-data-limit", s.putServerDataLimit)
+	handle("DELETE /server/access-key-data-limit", s.deleteServerDataLimit)
+	handle("PUT /name", s.putName)
+	handle("GET /metrics/enabled", s.getMetricsEnabled)
+	handle("PUT /metrics/enabled", s.putMetricsEnabled)
+	handle("GET /metrics/transfer", s.getMetricsTransfer)
+	handle("POST /access-keys", s.postAccessKey)
+	handle("GET /access-keys", s.getAccessKeys)
+	handle("GET /access-keys/{id}", s.getAccessKey)
+	handle("PUT /access-keys/{id}", s.putAccessKey)
+	handle("DELETE /access-keys/{id}", s.deleteAccessKey)
+	handle("PUT /access-keys/{id}/name", s.putAccessKeyName)
+	handle("PUT /access-keys/{id}/data-limit", s.putAccessKeyDataLimit)
+	handle("DELETE /access-keys/{id}/data-limit", s.deleteAccessKeyDataLimit)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "NotFound", r.Method+" "+strings.TrimPrefix(r.URL.Path, prefix)+" is not a known endpoint")
+	})
+
+	// Every request is recorded, with a gzip body as sent by [outline.WithRequestCompression] decompressed.
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			if zr, err := gzip.NewReader(bytes.NewReader(body)); err == nil {
+				body, _ = io.ReadAll(zr)
+			}
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if len(body) == 0 {
+			body = nil
+		}
+		s.mu.Lock()
+		s.requests = append(s.requests, Request{Method: r.Method, Path: strings.TrimPrefix(r.URL.Path, prefix), Body: body})
+		s.mu.Unlock()
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// === Server ===
+
+func (s *MockServer) getServer(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, s.info)
+}
+
+func (s *MockServer) putHostname(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Hostname string `json:"hostname"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.Hostname == "" {
+		writeError(w, http.StatusBadRequest, "InvalidHostname", "hostname must not be empty")
+		return
+	}
+
+	s.mu.Lock()
+	s.info.HostnameForAccessKeys = body.Hostname
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *MockServer) putPort(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Port int `json:"port"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.Port < 1 || body.Port > 65535 {
+		writeError(w, http.StatusBadRequest, "InvalidPort", "port must be from 1 through 65535")
+		return
+	}
+
+	s.mu.Lock()
+	s.info.PortForNewAccessKeys = body.Port
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *MockServer) putName(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name *string `json:"name"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.Name == nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "name must be a string")
+		return
+	}
+
+	s.mu.Lock()
+	s.info.Name = *body.Name
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *MockServer) putServerDataLimit(w http.ResponseWriter, r *http.Request) {
+	limit, ok := decodeLimit(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	s.info.AccessKeyDataLimit = limit
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *MockServer) deleteServerDataLimit(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	s.info.AccessKeyDataLimit = nil
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// === Metrics ===
+
+func (s *MockServer) getMetricsEnabled(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, types.MetricsEnabled{Enabled: s.info.MetricsEnabled})
+}
+
+func (s *MockServer) putMetricsEnabled(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Enabled *bool `json:"metricsEnabled"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.Enabled == nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "metricsEnabled must be a boolean")
+		return
+	}
+
+	s.mu.Lock()
+	s.info.MetricsEnabled = *body.Enabled
+	s.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *MockServer) getMetricsTransfer(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, types.MetricsTransfer{BytesTransferredByUserID: s.transfer})
+}
+
+// === Access keys ===
+
+func (s *MockServer) postAccessKey(w http.ResponseWriter, r *http.Request) {
+	var body types.CreateAccessKey
+	if !decodeBody(w, r, &body) || !validMethod(w, body.Method) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := s.storeKey(&types.AccessKey{
+		Name: body.Name, Password: body.Password, Port: int(body.Port), Method: body.Method, Limit: body.Limit,
+	})
+	writeJSON(w, http.StatusCreated, s.withAccessURL(key))
+}
+
+func (s *MockServer) getAccessKeys(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]types.AccessKey, 0, len(s.order))
+	for _, id := range s.order {
+		keys = append(keys, s.withAccessURL(s.keys[id]))
+	}
+	writeJSON(w, http.StatusOK, struct {
+		AccessKeys []types.AccessKey `json:"accessKeys"`
+	}{AccessKeys: keys})
+}
+
+func (s *MockServer) getAccessKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.findKey(w, r)
+	if !ok {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.withAccessURL(key))
+}
+
+func (s *MockServer) putAccessKey(w http.ResponseWriter, r *http.Request) {
+	var body types.CreateAccessKey
+	if !decodeBody(w, r, &body) || !validMethod(w, body.Method) {
+		return
+	}
+	id := r.PathValue("id")
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.keys[id]; exists {
+		writeError(w, http.StatusConflict, "Conflict", "access key "+id+" already exists")
+		return
+	}
+	key := s.storeKey(&types.AccessKey{
+		ID: id, Name: body.Name, Password: body.Password, Port: int(body.Port), Method: body.Method, Limit: body.Limit,
+	})
+	writeJSON(w, http.StatusCreated, s.withAccessURL(key))
+}
+
+func (s *MockServer) deleteAccessKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.findKey(w, r)
+	if !ok {
+		return
+	}
+	delete(s.keys, key.ID)
+	s.order = slices.DeleteFunc(s.order, func(id string) bool { return id == key.ID })
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *MockServer) putAccessKeyName(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		Name *string `json:"name"`
+	}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	if body.Name == nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "name must be a string")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.findKey(w, r)
+	if !ok {
+		return
+	}
+	key.Name = *body.Name
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *MockServer) putAccessKeyDataLimit(w http.ResponseWriter, r *http.Request) {
+	limit, ok := decodeLimit(w, r)
+	if !ok {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.findKey(w, r)
+	if !ok {
+		return
+	}
+	key.Limit = limit
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *MockServer) deleteAccessKeyDataLimit(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.findKey(w, r)
+	if !ok {
+		return
+	}
+	key.Limit = nil
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// storeKey fills in the defaults of a created key and stores it. The caller must hold s.mu.
+func (s *MockServer) storeKey(key *types.AccessKey) *types.AccessKey {
+	stored := *key
+	if stored.ID == "" {
+		for s.keys[strconv.Itoa(s.nextID)] != nil {
+			s.nextID++
+		}
+		stored.ID = strconv.Itoa(s.nextID)
+	}
+	if stored.Password == "" {
+		stored.Password = "password-" + stored.ID
+	}
+	if stored.Port == 0 {
+		stored.Port = s.info.PortForNewAccessKeys
+	}
+	if stored.Method == "" {
+		stored.Method = types.MethodChaCha20IETFPoly1305
+	}
+	if stored.Limit != nil {
+		stored.Limit = &types.Limit{Bytes: stored.Limit.Bytes}
+	}
+	stored.AccessURL = ""
+
+	if _, exists := s.keys[stored.ID]; !exists {
+		s.order = append(s.order, stored.ID)
+	}
+	s.keys[stored.ID] = &stored
+	return &stored
+}
+
+// findKey returns the key with the ID of the request path or answers 404. The caller must hold s.mu.
+func (s *MockServer) findKey(w http.ResponseWriter, r *http.Request) (*types.AccessKey, bool) {
+	id := r.PathValue("id")
+	key, ok := s.keys[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NotFound", "access key "+id+" not found")
+	}
+	return key, ok
+}
+
+// withAccessURL returns a copy of key with the access URL for the current hostname. The caller must hold s.mu.
+func (s *MockServer) withAccessURL(key *types.AccessKey) types.AccessKey {
+	out := *key
+	out.AccessURL, _ = types.BuildAccessURL(types.ShadowsocksConfig{
+		Method:   key.Method,
+		Password: key.Password,
+		Host:     s.info.HostnameForAccessKeys,
+		Port:     key.Port,
+		Tag:      key.Name,
+	})
+	return out
+}
+
+// validMethod answers 400 and reports false if method is set but not supported.
+func validMethod(w http.ResponseWriter, method string) bool {
+	if method != "" && !types.IsValidEncryptionMethod(method) {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("unsupported encryption method %q", method))
+		return false
+	}
+	return true
+}
+
+// decodeLimit decodes a {"limit":{"bytes":N}} body or answers 400.
+func decodeLimit(w http.ResponseWriter, r *http.Request) (*types.Limit, bool) {
+	var body struct {
+		Limit *types.Limit `json:"limit"`
+	}
+	if !decodeBody(w, r, &body) {
+		return nil, false
+	}
+	if body.Limit == nil {
+		writeError(w, http.StatusBadRequest, "InvalidDataLimit", "limit.bytes must be a number")
+		return nil, false
+	}
+	return body.Limit, true
+}
+
+// decodeBody decodes the JSON request body into v or answers 400. An empty body decodes as {}.
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	body, _ := io.ReadAll(r.Body)
+	if len(bytes.TrimSpace(body)) == 0 {
+		return true
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidArgument", "invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+// writeError answers with the status and an Outline error body.
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, types.APIError{Code: code, Message: message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
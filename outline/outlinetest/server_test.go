@@ -0,0 +1,282 @@
+package outlinetest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/outline"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) (*MockServer, *outline.Client) {
+	t.Helper()
+
+	srv := NewMockServer()
+	t.Cleanup(srv.Close)
+	client, err := srv.NewClient()
+	require.NoError(t, err)
+
+	return srv, client
+}
+
+func TestMockServer_AccessKeyLifecycle(t *testing.T) {
+	// Arrange
+	srv, client := newTestServer(t)
+	ctx := context.Background()
+
+	// Act
+	created, createErr := client.CreateAccessKey(ctx, &types.CreateAccessKey{Name: "alice", Method: types.MethodAES256GCM})
+	renameErr := client.UpdateNameAccessKey(ctx, created.ID, "bob")
+	limitErr := client.UpdateDataLimitAccessKey(ctx, created.ID, 1000)
+	got, getErr := client.GetAccessKey(ctx, created.ID)
+	deleteErr := client.DeleteAccessKey(ctx, created.ID)
+	_, missingErr := client.GetAccessKey(ctx, created.ID)
+
+	// Assert
+	require.NoError(t, createErr)
+	require.NoError(t, renameErr)
+	require.NoError(t, limitErr)
+	require.NoError(t, getErr)
+	require.NoError(t, deleteErr)
+	assert.Equal(t, "0", created.ID)
+	assert.Equal(t, 8388, created.Port)
+	assert.Equal(t, types.MethodAES256GCM, created.Method)
+	assert.Equal(t, "bob", got.Name)
+	assert.Equal(t, &types.Limit{Bytes: 1000}, got.Limit)
+	cfg, err := got.ParseAccessURL()
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1", cfg.Host)
+	assert.Equal(t, got.Password, cfg.Password)
+	assert.ErrorIs(t, missingErr, outline.AccessKeyNotFoundError)
+	assert.Empty(t, srv.AccessKeys())
+	srv.AssertRequested(t, http.MethodPost, "/access-keys")
+	srv.AssertRequested(t, http.MethodPut, "/access-keys/0/name")
+	srv.AssertRequested(t, http.MethodDelete, "/access-keys/0")
+}
+
+func TestMockServer_SeedAccessKeys(t *testing.T) {
+	// Arrange
+	srv, client := newTestServer(t)
+	srv.SeedAccessKeys(
+		types.AccessKey{Name: "alice"},
+		types.AccessKey{ID: "seeded", Name: "bob", Port: 9000, Limit: &types.Limit{Bytes: 5}},
+	)
+	srv.SetBytesTransferred("0", 42)
+
+	// Act
+	keys, keysErr := client.GetAccessKeys(context.Background())
+	transfer, transferErr := client.GetMetricsTransfer(context.Background())
+
+	// Assert
+	require.NoError(t, keysErr)
+	require.NoError(t, transferErr)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "0", keys[0].ID)
+	assert.Equal(t, "alice", keys[0].Name)
+	assert.Equal(t, types.MethodChaCha20IETFPoly1305, keys[0].Method)
+	assert.Equal(t, "seeded", keys[1].ID)
+	assert.Equal(t, 9000, keys[1].Port)
+	assert.Equal(t, &types.Limit{Bytes: 5}, keys[1].Limit)
+	assert.Equal(t, map[string]int64{"0": 42}, transfer.BytesTransferredByUserID)
+}
+
+func TestMockServer_CreateWithID(t *testing.T) {
+	// Arrange
+	srv, client := newTestServer(t)
+	srv.SeedAccessKeys(types.AccessKey{ID: "taken"})
+	ctx := context.Background()
+
+	// Act
+	created, createErr := client.CreateAccessKeyWithID(ctx, "custom", &types.CreateAccessKey{Name: "alice"})
+	_, conflictErr := client.CreateAccessKeyWithID(ctx, "taken", &types.CreateAccessKey{})
+	_, updateErr := client.UpdateAccessKey(ctx, "custom", &types.AccessKey{ID: "custom", Name: "carol", Port: 9001})
+
+	// Assert
+	require.NoError(t, createErr)
+	assert.Equal(t, "custom", created.ID)
+	assert.ErrorIs(t, conflictErr, outline.KeyAlreadyExistsError)
+	assert.ErrorIs(t, updateErr, outline.KeyAlreadyExistsError)
+	keys := srv.AccessKeys()
+	require.Len(t, keys, 2)
+	assert.Equal(t, "alice", keys[1].Name)
+}
+
+func TestMockServer_RecreateAccessKey(t *testing.T) {
+	// Arrange
+	srv, client := newTestServer(t)
+	srv.SeedAccessKeys(types.AccessKey{
+		ID: "1", Name: "alice", Password: "old", Port: 8080, Method: types.MethodAES256GCM, Limit: &types.Limit{Bytes: 5},
+	})
+	ctx := context.Background()
+
+	// Act
+	moved, moveErr := client.UpdateAccessKeyPort(ctx, "1", 9090)
+	rotated, rotateErr := client.RotateAccessKeyPassword(ctx, "1", "")
+
+	// Assert
+	require.NoError(t, moveErr)
+	require.NoError(t, rotateErr)
+	assert.Equal(t, 9090, moved.Port)
+	assert.Equal(t, "old", moved.Password)
+	assert.NotEqual(t, "old", rotated.Password)
+	assert.Equal(t, 9090, rotated.Port)
+	assert.Equal(t, []types.AccessKey{*rotated}, srv.AccessKeys())
+	assert.Equal(t, &types.Limit{Bytes: 5}, rotated.Limit)
+}
+
+func TestMockServer_SyncAccessKeys(t *testing.T) {
+	// Arrange
+	srv, client := newTestServer(t)
+	srv.SeedAccessKeys(
+		types.AccessKey{ID: "1", Name: "alice", Password: "p1", Port: 8080, Method: types.MethodAES128GCM},
+		types.AccessKey{ID: "2", Name: "bob", Password: "p2", Port: 8080, Method: types.MethodAES128GCM, Limit: &types.Limit{Bytes: 5}},
+	)
+	ctx := context.Background()
+
+	// Act
+	result, err := client.SyncAccessKeys(ctx, []types.DesiredKey{
+		{Name: "alice", Method: types.MethodAES256GCM, Port: 9090, Limit: &types.Limit{Bytes: 500}},
+		{Name: "bob"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, types.SyncResult{Updated: []string{"1", "2"}}, result)
+	alice, err := client.GetAccessKey(ctx, "1")
+	require.NoError(t, err)
+	bob, err := client.GetAccessKey(ctx, "2")
+	require.NoError(t, err)
+	assert.Len(t, srv.AccessKeys(), 2)
+	assert.Equal(t, "alice", alice.Name)
+	assert.Equal(t, "p1", alice.Password)
+	assert.Equal(t, 9090, alice.Port)
+	assert.Equal(t, types.MethodAES256GCM, alice.Method)
+	assert.Equal(t, &types.Limit{Bytes: 500}, alice.Limit)
+	assert.Nil(t, bob.Limit)
+}
+
+func TestMockServer_ServerConfiguration(t *testing.T) {
+	// Arrange
+	srv, client := newTestServer(t)
+	ctx := context.Background()
+
+	// Act
+	require.NoError(t, client.UpdateServerName(ctx, "eu-1"))
+	require.NoError(t, client.UpdateServerHostname(ctx, "vpn.example.com"))
+	require.NoError(t, client.UpdatePortNewAccessKeys(ctx, 9443))
+	require.NoError(t, client.UpdateMetricsEnabled(ctx, true))
+	require.NoError(t, client.UpdateKeyLimitBytes(ctx, 2048))
+	info, infoErr := client.GetServerInfo(ctx)
+	require.NoError(t, client.DeleteKeyLimitBytes(ctx))
+	metrics, metricsErr := client.GetMetricsEnabled(ctx)
+	key, keyErr := client.CreateAccessKey(ctx, &types.CreateAccessKey{})
+
+	// Assert
+	require.NoError(t, infoErr)
+	require.NoError(t, metricsErr)
+	require.NoError(t, keyErr)
+	assert.Equal(t, "eu-1", info.Name)
+	assert.Equal(t, "vpn.example.com", info.HostnameForAccessKeys)
+	assert.Equal(t, 9443, info.PortForNewAccessKeys)
+	assert.Equal(t, &types.Limit{Bytes: 2048}, info.AccessKeyDataLimit)
+	assert.Nil(t, srv.ServerInfo().AccessKeyDataLimit)
+	assert.True(t, metrics.Enabled)
+	assert.Equal(t, 9443, key.Port)
+}
+
+func TestMockServer_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		call        func(ctx context.Context, c *outline.Client) error
+		expectedErr error
+	}{
+		{
+			name: "invalid hostname",
+			call: func(ctx context.Context, c *outline.Client) error {
+				return c.UpdateServerHostname(ctx, "")
+			},
+			expectedErr: outline.InvalidHostnameError,
+		},
+		{
+			name: "invalid port",
+			call: func(ctx context.Context, c *outline.Client) error {
+				return c.UpdatePortNewAccessKeys(ctx, 0)
+			},
+			expectedErr: outline.InvalidPortError,
+		},
+		{
+			name: "unknown access key",
+			call: func(ctx context.Context, c *outline.Client) error {
+				return c.DeleteAccessKey(ctx, "missing")
+			},
+			expectedErr: outline.AccessKeyNotFoundError,
+		},
+		{
+			name: "experimental metrics",
+			call: func(ctx context.Context, c *outline.Client) error {
+				_, err := c.GetExperimentalMetrics(ctx, time.Hour)
+				return err
+			},
+			expectedErr: outline.ExperimentalMetricsUnsupportedError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			_, client := newTestServer(t)
+
+			// Act
+			err := tt.call(context.Background(), client)
+
+			// Assert
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestMockServer_WrongSecret(t *testing.T) {
+	// Arrange
+	srv := NewMockServer()
+	t.Cleanup(srv.Close)
+	client, err := outline.NewClient(srv.URL, "wrong")
+	require.NoError(t, err)
+
+	// Act
+	_, err = client.GetServerInfo(context.Background())
+
+	// Assert
+	var clientErr *outline.ClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, http.StatusNotFound, clientErr.StatusCode())
+	assert.Equal(t, []Request{{Method: http.MethodGet, Path: "/wrong/server"}}, srv.Requests())
+}
+
+func TestMockServer_AssertRequested(t *testing.T) {
+	// Arrange
+	srv, client := newTestServer(t)
+	_, err := client.GetServerInfo(context.Background())
+	require.NoError(t, err)
+	recorder := &recordingTB{TB: t}
+
+	// Act
+	found := srv.AssertRequested(recorder, http.MethodGet, "/server")
+	missing := srv.AssertRequested(recorder, http.MethodDelete, "/server")
+
+	// Assert
+	assert.True(t, found)
+	assert.False(t, missing)
+	assert.Equal(t, 1, recorder.errors)
+}
+
+// recordingTB counts the failures reported through it instead of failing the test.
+type recordingTB struct {
+	testing.TB
+	errors int
+}
+
+func (r *recordingTB) Errorf(string, ...any) { r.errors++ }
@@ -23,10 +23,21 @@ func maskSecretPath(raw, secret string) string {
 	return strings.Join(parts, "/")
 }
 
-// setIDInPath replaces the {id} placeholder in the URL path with the actual id.
-// It returns the full URL string with the id substituted.
-func setIDInPath(u url.URL, id string) string {
-	replacedPath := strings.Replace(u.Path, "{id}", id, 1)
-	u.Path = replacedPath
+// setIDInPath replaces every {id} placeholder in the URL path with the actual id.
+// Placeholders within basePath, the base path and secret the endpoints are resolved against,
+// are kept, so a secret that happens to contain "{id}" is never altered; an empty basePath
+// or one that is not a prefix of the path replaces placeholders in the whole path.
+// It returns the full URL string with the id substituted, keeping the query string.
+func setIDInPath(u url.URL, basePath, id string) string {
+	prefix := ""
+	if strings.HasPrefix(u.Path, basePath) {
+		prefix = basePath
+	}
+	u.Path = prefix + strings.ReplaceAll(u.Path[len(prefix):], "{id}", id)
 	return u.String()
 }
+
+// idPath returns the endpoint u with the access key id substituted for its {id} placeholder.
+func (c *Client) idPath(u *url.URL, id string) string {
+	return setIDInPath(*u, c.basePath, id)
+}
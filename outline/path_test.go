@@ -124,6 +124,7 @@ func TestSetIDInPath(t *testing.T) {
 	tests := []struct {
 		name     string
 		urlStr   string
+		basePath string
 		id       string
 		expected string
 	}{
@@ -140,10 +141,31 @@ func TestSetIDInPath(t *testing.T) {
 			expected: "/api/data",
 		},
 		{
-			name:     "Multiple {id}, replace only first",
+			name:     "Multiple {id}, replace all",
 			urlStr:   "/{id}/foo/{id}/bar",
 			id:       "123",
-			expected: "/123/foo/%7Bid%7D/bar",
+			expected: "/123/foo/123/bar",
+		},
+		{
+			name:     "Two placeholders with query parameters",
+			urlStr:   "http://example.com/api/access-keys/{id}/audit/{id}?since=1h&limit=10",
+			basePath: "/api",
+			id:       "7",
+			expected: "http://example.com/api/access-keys/7/audit/7?since=1h&limit=10",
+		},
+		{
+			name:     "{id} in secret kept",
+			urlStr:   "http://example.com/api/se{id}cret/access-keys/{id}",
+			basePath: "/api/se{id}cret",
+			id:       "7",
+			expected: "http://example.com/api/se%7Bid%7Dcret/access-keys/7",
+		},
+		{
+			name:     "Base path not a prefix",
+			urlStr:   "http://example.com/other/{id}",
+			basePath: "/api",
+			id:       "7",
+			expected: "http://example.com/other/7",
 		},
 		{
 			name:     "{id} at start",
@@ -229,8 +251,8 @@ func TestSetIDInPath(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			u, err := url.Parse(tt.urlStr)
 			assert.NoError(t, err, "url.Parse should not fail for %q", tt.urlStr)
-			result := setIDInPath(*u, tt.id)
-			assert.Equal(t, tt.expected, result, "setIDInPath(%q, %q)", tt.urlStr, tt.id)
+			result := setIDInPath(*u, tt.basePath, tt.id)
+			assert.Equal(t, tt.expected, result, "setIDInPath(%q, %q, %q)", tt.urlStr, tt.basePath, tt.id)
 		})
 	}
 }
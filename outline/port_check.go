@@ -0,0 +1,48 @@
+package outline
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+)
+
+// defaultPortCheckTimeout bounds the dial of [Client.CheckAccessKeyPortOpen] unless [WithPortCheckTimeout] is set.
+const defaultPortCheckTimeout = 5 * time.Second
+
+// CheckAccessKeyPortOpen reports whether the port for new access keys accepts TCP connections,
+// confirming that clients can reach the server beyond the management API.
+// It dials the address returned by [Client.ConnectionInfo] without a Shadowsocks handshake,
+// so it only proves that something listens on the port. The dial is bounded by ctx and by the
+// timeout set with [WithPortCheckTimeout], 5 seconds by default.
+//
+// A failed dial is reported as false with a nil error. It returns [*ClientError] wrapping [InvalidPortError]
+// if the server reports no port, the context error if ctx is done before the dial completes,
+// and otherwise the errors of [Client.ConnectionInfo].
+func (c *Client) CheckAccessKeyPortOpen(ctx context.Context) (bool, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	info, err := c.ConnectionInfo(ctx)
+	if err != nil {
+		return false, err
+	}
+	if info.Port <= 0 {
+		return false, c.finishClientError(ctx, errNoAccessKeyPort(opCheckAccessKeyPortOpen))
+	}
+
+	timeout := c.portCheckTimeout
+	if timeout <= 0 {
+		timeout = defaultPortCheckTimeout
+	}
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(info.Hostname, strconv.Itoa(info.Port)))
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return false, ctxErr
+		}
+		return false, nil
+	}
+	_ = conn.Close()
+	return true, nil
+}
@@ -0,0 +1,92 @@
+package outline
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newPortCheckClient returns a client whose server reports hostname and port for new access keys.
+func newPortCheckClient(t *testing.T, hostname string, port int) *Client {
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return jsonResponse(http.StatusOK, types.ServerInfoResponse{
+			HostnameForAccessKeys: hostname,
+			PortForNewAccessKeys:  port,
+		}), nil
+	})
+	return MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithPortCheckTimeout(time.Second))
+}
+
+// === CheckAccessKeyPortOpen Tests ===
+
+func TestCheckAccessKeyPortOpen_Open(t *testing.T) {
+	// Arrange
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+	client := newPortCheckClient(t, "127.0.0.1", ln.Addr().(*net.TCPAddr).Port)
+
+	// Act
+	open, err := client.CheckAccessKeyPortOpen(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, open)
+}
+
+func TestCheckAccessKeyPortOpen_Closed(t *testing.T) {
+	// Arrange
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := ln.Addr().(*net.TCPAddr).Port
+	require.NoError(t, ln.Close())
+	client := newPortCheckClient(t, "127.0.0.1", port)
+
+	// Act
+	open, err := client.CheckAccessKeyPortOpen(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.False(t, open)
+}
+
+func TestCheckAccessKeyPortOpen_NoPort(t *testing.T) {
+	// Arrange
+	client := newPortCheckClient(t, "127.0.0.1", 0)
+
+	// Act
+	open, err := client.CheckAccessKeyPortOpen(context.Background())
+
+	// Assert
+	assert.False(t, open)
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, InvalidPortError)
+	var clientErr *ClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, opCheckAccessKeyPortOpen, clientErr.Operation())
+	assert.Zero(t, clientErr.StatusCode())
+}
+
+func TestCheckAccessKeyPortOpen_ContextCanceled(t *testing.T) {
+	// Arrange
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+	client := newPortCheckClient(t, "127.0.0.1", ln.Addr().(*net.TCPAddr).Port)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	open, err := client.CheckAccessKeyPortOpen(ctx)
+
+	// Assert
+	assert.False(t, open)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,33 @@
+package outline
+
+import (
+	"context"
+)
+
+// GetAccessKeyPublicURL returns the access URL of the key with its host replaced by the
+// server HostnameForAccessKeys, for servers behind NAT whose access URLs carry an internal address.
+// The method, password, port and tag of the access URL are preserved.
+// If the server reports no hostname, the access URL is returned unchanged.
+//
+// It returns the errors of [Client.GetAccessKey] and [Client.GetServerInfo],
+// or [*ClientError] wrapping [types.InvalidAccessURLError] if the key has no valid access URL.
+func (c *Client) GetAccessKeyPublicURL(ctx context.Context, keyID string) (string, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	key, err := c.getAccessKey(ctx, keyID, false)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := c.GetServerInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	publicURL, err := key.AccessURLWithHost(info.HostnameForAccessKeys)
+	if err != nil {
+		return "", c.finishClientError(ctx, errInvalidAccessURL(opGetAccessKeyPublicURL, keyID, err))
+	}
+	return publicURL, nil
+}
@@ -0,0 +1,107 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === GetAccessKeyPublicURL Tests ===
+
+func TestGetAccessKeyPublicURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		accessURL string
+		hostname  string
+		expected  string
+	}{
+		{
+			name:      "ip rewritten to hostname",
+			accessURL: "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@10.0.0.5:8388/?outline=1#Laptop",
+			hostname:  "vpn.example.com",
+			expected:  "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@vpn.example.com:8388/?outline=1#Laptop",
+		},
+		{
+			name:      "no hostname configured",
+			accessURL: "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@10.0.0.5:8388/?outline=1",
+			hostname:  "",
+			expected:  "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@10.0.0.5:8388/?outline=1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+				if requestPath(req) == "/server" {
+					return jsonResponse(http.StatusOK, types.ServerInfoResponse{HostnameForAccessKeys: tt.hostname}), nil
+				}
+				return jsonResponse(http.StatusOK, types.AccessKey{ID: "1", AccessURL: tt.accessURL}), nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			publicURL, err := client.GetAccessKeyPublicURL(context.Background(), "1")
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, publicURL)
+		})
+	}
+}
+
+func TestGetAccessKeyPublicURL_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		keyStatus    int
+		accessURL    string
+		expectedErr  error
+		expectedOp   string
+		expectedCode int
+	}{
+		{
+			name:         "key not found",
+			keyStatus:    http.StatusNotFound,
+			expectedErr:  AccessKeyNotFoundError,
+			expectedOp:   opGetAccessKey,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:        "invalid access url",
+			keyStatus:   http.StatusOK,
+			accessURL:   "not a url",
+			expectedErr: types.InvalidAccessURLError,
+			expectedOp:  opGetAccessKeyPublicURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+				if requestPath(req) == "/server" {
+					return jsonResponse(http.StatusOK, types.ServerInfoResponse{HostnameForAccessKeys: "vpn.example.com"}), nil
+				}
+				return jsonResponse(tt.keyStatus, types.AccessKey{ID: "1", AccessURL: tt.accessURL}), nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			publicURL, err := client.GetAccessKeyPublicURL(context.Background(), "1")
+
+			// Assert
+			assert.Empty(t, publicURL)
+			assert.ErrorIs(t, err, ClientOutlineError)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			var clientErr *ClientError
+			require.ErrorAs(t, err, &clientErr)
+			assert.Equal(t, tt.expectedOp, clientErr.Operation())
+			assert.Equal(t, tt.expectedCode, clientErr.StatusCode())
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package outline
+
+import (
+	"context"
+	"fmt"
+)
+
+// waitRateLimit blocks until the limiter set by [WithRateLimit] admits another request.
+// It returns the context error if ctx is done first, or wraps [context.DeadlineExceeded]
+// if the request could not be admitted before the deadline of ctx.
+func (c *Client) waitRateLimit(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+	if err := c.limiter.Wait(ctx); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
+	}
+	return nil
+}
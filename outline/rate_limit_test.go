@@ -0,0 +1,104 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithRateLimit Tests ===
+
+func TestWithRateLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		concurrent bool
+	}{
+		{name: "sequential"},
+		{name: "concurrent", concurrent: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			const requests = 5
+			var sent atomic.Int32
+			mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+				sent.Add(1)
+				return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "secret", WithClient(mockDoer), WithRateLimit(20, 1))
+			var wg sync.WaitGroup
+			start := time.Now()
+
+			// Act
+			for range requests {
+				call := func() { assert.NoError(t, client.DeleteAccessKey(context.Background(), "1")) }
+				if !tt.concurrent {
+					call()
+					continue
+				}
+				wg.Go(call)
+			}
+			wg.Wait()
+			elapsed := time.Since(start)
+
+			// Assert
+			assert.EqualValues(t, requests, sent.Load())
+			// The burst admits the first request at once, each of the others waits 1/20 s.
+			assert.GreaterOrEqual(t, elapsed, (requests-1)*50*time.Millisecond-5*time.Millisecond)
+		})
+	}
+}
+
+func TestWithRateLimit_ContextDone(t *testing.T) {
+	// Arrange
+	var sent atomic.Int32
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		sent.Add(1)
+		return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "secret", WithClient(mockDoer), WithRateLimit(0.1, 1))
+	require.NoError(t, client.DeleteAccessKey(context.Background(), "1"))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+
+	// Act
+	err := client.DeleteAccessKey(ctx, "1")
+
+	// Assert
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.EqualValues(t, 1, sent.Load())
+}
+
+func TestWithRateLimit_Rejected(t *testing.T) {
+	tests := []struct {
+		name  string
+		rps   float64
+		burst int
+	}{
+		{name: "zero rps", rps: 0, burst: 1},
+		{name: "negative rps", rps: -1, burst: 1},
+		{name: "zero burst", rps: 1, burst: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClient("https://127.0.0.1:1234/", "secret", WithRateLimit(tt.rps, tt.burst))
+
+			// Assert
+			assert.Nil(t, client)
+			assert.ErrorIs(t, err, InvalidOptionError)
+			assert.Contains(t, err.Error(), "option: WithRateLimit")
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+)
+
+// maxRetryDelay caps the exponential backoff between two attempts.
+const maxRetryDelay = 30 * time.Second
+
+// defaultRetryableStatuses are the status codes retried unless [WithRetryableStatuses] is set.
+var defaultRetryableStatuses = map[int]struct{}{
+	http.StatusBadGateway:         {},
+	http.StatusServiceUnavailable: {},
+	http.StatusGatewayTimeout:     {},
+}
+
+// RetryPolicy decides whether [WithRetry] retries a request after a failed attempt.
+// ShouldRetry receives either the response of the attempt or the error of the [Doer], with resp nil.
+// Errors caused by the context are never passed to it and never retried.
+type RetryPolicy interface {
+	ShouldRetry(resp *Response, err error) bool
+}
+
+// RetryPolicyFunc adapts a function to the [RetryPolicy] interface.
+type RetryPolicyFunc func(resp *Response, err error) bool
+
+// ShouldRetry calls f(resp, err).
+func (f RetryPolicyFunc) ShouldRetry(resp *Response, err error) bool {
+	return f(resp, err)
+}
+
+// retryConfig holds the settings of [WithRetry], [WithRetryableStatuses], [WithRetryableErrorFunc],
+// [WithRetryPolicy] and [WithRetryCreateOnIdempotencyKey].
+type retryConfig struct {
+	maxAttempts            int
+	baseDelay              time.Duration
+	statuses               map[int]struct{}
+	errorFunc              func(error) bool
+	policy                 RetryPolicy
+	createOnIdempotencyKey bool
+}
+
+// sendWithRetry sends the request through the configured [Doer], retrying idempotent
+// requests as configured by [WithRetry]. Requests with other methods are sent once,
+// except for the creations allowed by [WithRetryCreateOnIdempotencyKey].
+// A nil response without an error is reported as [NilResponseError] and not retried.
+func (c *Client) sendWithRetry(ctx context.Context, methodName string, req *contracts.Request) (*contracts.Response, error) {
+	attempts := 1
+	if c.retry.maxAttempts > 1 && (isIdempotentMethod(req.Method) || c.retry.retriesCreate(methodName, req)) {
+		attempts = c.retry.maxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		if err := c.waitRateLimit(ctx); err != nil {
+			return nil, err
+		}
+		start := time.Now()
+		resp, err := c.doer.Do(ctx, req)
+		c.observeRequest(methodName, req, attempt, resp, err, time.Since(start))
+		if err == nil && resp == nil {
+			return nil, NilResponseError
+		}
+		if attempt >= attempts || !c.retry.shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		delay := withJitter(c.retry.backoff(attempt))
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			// The next attempt could not start before the deadline: report the last outcome instead.
+			return resp, err
+		}
+		c.logger.Debugf(ctx, "%s: retrying request: attempt=%d delay=%s", methodName, attempt+1, delay)
+		if err := sleepContext(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry reports whether the outcome of an attempt is retryable.
+// Errors caused by the context are never retried.
+func (r *retryConfig) shouldRetry(resp *contracts.Response, err error) bool {
+	if err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		return false
+	}
+	if r.policy != nil {
+		return r.policy.ShouldRetry(resp, err)
+	}
+
+	if err != nil {
+		if r.errorFunc != nil {
+			return r.errorFunc(err)
+		}
+		return true
+	}
+	if resp == nil {
+		return false
+	}
+
+	statuses := r.statuses
+	if statuses == nil {
+		statuses = defaultRetryableStatuses
+	}
+	_, ok := statuses[resp.StatusCode]
+	return ok
+}
+
+// backoff returns the delay before the attempt following the given one,
+// doubling the base delay on every attempt up to [maxRetryDelay].
+func (r *retryConfig) backoff(attempt int) time.Duration {
+	delay := r.baseDelay
+	for range attempt - 1 {
+		if delay >= maxRetryDelay/2 {
+			return maxRetryDelay
+		}
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// withJitter returns a random delay from d/2 through d, so that clients
+// failing at the same time do not retry in lockstep.
+func withJitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
+
+// isIdempotentMethod reports whether a request with the HTTP method can be repeated safely.
+// PUT and DELETE are left out: if the response to a successful attempt is lost, the retry of
+// PUT /access-keys/{id} is answered with 409 and the retry of a deletion with 404,
+// reporting a failure for a call that succeeded.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	default:
+		return false
+	}
+}
+
+// retriesCreate reports whether the request is a [Client.CreateAccessKey] call
+// that [WithRetryCreateOnIdempotencyKey] makes retryable because it carries an idempotency key.
+func (r *retryConfig) retriesCreate(methodName string, req *contracts.Request) bool {
+	return r.createOnIdempotencyKey && methodName == "CreateAccessKey" && req.Headers[idempotencyKeyHeader] != ""
+}
+
+// sleepContext waits for d or until ctx is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
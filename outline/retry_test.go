@@ -0,0 +1,423 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSequenceMockDoer answers the requests with the given responses in order,
+// repeating the last one, and records "METHOD /path" for each request in calls.
+func newSequenceMockDoer(t *testing.T, calls *[]string, responses ...*contracts.Response) *MockDoer {
+	var n int
+	return newRoutingMockDoer(t, calls, func(*contracts.Request) (*contracts.Response, error) {
+		resp := responses[min(n, len(responses)-1)]
+		n++
+		return resp, nil
+	})
+}
+
+// === WithRetry Tests ===
+
+func TestWithRetry_StatusCodes(t *testing.T) {
+	okKey := jsonResponse(http.StatusOK, types.AccessKey{ID: "1"})
+
+	tests := []struct {
+		name          string
+		options       []Option
+		first         int
+		expectedCalls int
+		expectedErr   error
+	}{
+		{
+			name:          "503 retried by default",
+			first:         http.StatusServiceUnavailable,
+			expectedCalls: 2,
+		},
+		{
+			name:          "404 not retried by default",
+			first:         http.StatusNotFound,
+			expectedCalls: 1,
+			expectedErr:   AccessKeyNotFoundError,
+		},
+		{
+			name:          "400 not retried by default",
+			first:         http.StatusBadRequest,
+			expectedCalls: 1,
+			expectedErr:   UnexpectedStatusCodeError,
+		},
+		{
+			name:          "404 made retryable",
+			options:       []Option{WithRetryableStatuses(http.StatusNotFound)},
+			first:         http.StatusNotFound,
+			expectedCalls: 2,
+		},
+		{
+			name:          "503 excluded",
+			options:       []Option{WithRetryableStatuses(http.StatusNotFound)},
+			first:         http.StatusServiceUnavailable,
+			expectedCalls: 1,
+			expectedErr:   ServiceUnavailableError,
+		},
+		{
+			name:          "no retryable statuses",
+			options:       []Option{WithRetryableStatuses()},
+			first:         http.StatusBadGateway,
+			expectedCalls: 1,
+			expectedErr:   ServiceUnavailableError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newSequenceMockDoer(t, &calls, &contracts.Response{StatusCode: tt.first}, okKey)
+			options := append([]Option{WithClient(mockDoer), WithRetry(3, 0)}, tt.options...)
+			client := MustNewClient("http://localhost:8081/api/", "", options...)
+
+			// Act
+			key, err := client.GetAccessKey(context.Background(), "1")
+
+			// Assert
+			assert.Len(t, calls, tt.expectedCalls)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "1", key.ID)
+		})
+	}
+}
+
+func TestWithRetry_StopsAfterMaxAttempts(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newSequenceMockDoer(t, &calls, &contracts.Response{StatusCode: http.StatusGatewayTimeout})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithRetry(3, 0))
+
+	// Act
+	_, err := client.GetAccessKeys(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, ServiceUnavailableError)
+	assert.Len(t, calls, 3)
+}
+
+func TestWithRetry_NonIdempotentNotRetried(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newSequenceMockDoer(t, &calls, &contracts.Response{StatusCode: http.StatusServiceUnavailable})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithRetry(3, 0))
+
+	// Act
+	_, err := client.CreateAccessKey(context.Background(), &types.CreateAccessKey{Name: "alice"})
+
+	// Assert
+	assert.ErrorIs(t, err, ServiceUnavailableError)
+	assert.Equal(t, []string{"POST /access-keys"}, calls)
+}
+
+func TestWithRetryCreateOnIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name               string
+		options            []Option
+		idempotencyKey     string
+		expectedCalls      int
+		expectedErr        error
+		expectedKeyHeaders []string
+	}{
+		{
+			name:               "retried with idempotency key",
+			options:            []Option{WithRetryCreateOnIdempotencyKey()},
+			idempotencyKey:     "create-alice",
+			expectedCalls:      2,
+			expectedKeyHeaders: []string{"create-alice", "create-alice"},
+		},
+		{
+			name:               "not retried without idempotency key",
+			options:            []Option{WithRetryCreateOnIdempotencyKey()},
+			expectedCalls:      1,
+			expectedErr:        ServiceUnavailableError,
+			expectedKeyHeaders: []string{""},
+		},
+		{
+			name:               "not retried without option",
+			idempotencyKey:     "create-alice",
+			expectedCalls:      1,
+			expectedErr:        ServiceUnavailableError,
+			expectedKeyHeaders: []string{"create-alice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var (
+				calls      []string
+				keyHeaders []string
+			)
+			responses := []*contracts.Response{
+				{StatusCode: http.StatusServiceUnavailable},
+				jsonResponse(http.StatusCreated, types.AccessKey{ID: "1", Name: "alice"}),
+			}
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				keyHeaders = append(keyHeaders, req.Headers[idempotencyKeyHeader])
+				return responses[len(keyHeaders)-1], nil
+			})
+			options := append([]Option{WithClient(mockDoer), WithRetry(3, 0)}, tt.options...)
+			client := MustNewClient("http://localhost:8081/api/", "", options...)
+			ctx := ContextWithIdempotencyKey(context.Background(), tt.idempotencyKey)
+
+			// Act
+			key, err := client.CreateAccessKey(ctx, &types.CreateAccessKey{Name: "alice"})
+
+			// Assert
+			assert.Len(t, calls, tt.expectedCalls)
+			assert.Equal(t, tt.expectedKeyHeaders, keyHeaders)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "1", key.ID)
+		})
+	}
+}
+
+func TestWithRetry_DoerErrors(t *testing.T) {
+	errNetwork := errors.New("connection reset")
+	errPermanent := errors.New("permanent")
+
+	tests := []struct {
+		name          string
+		options       []Option
+		err           error
+		expectedCalls int
+	}{
+		{
+			name:          "network error retried",
+			err:           errNetwork,
+			expectedCalls: 3,
+		},
+		{
+			name: "error func rejects",
+			options: []Option{WithRetryableErrorFunc(func(err error) bool {
+				return !errors.Is(err, errPermanent)
+			})},
+			err:           errPermanent,
+			expectedCalls: 1,
+		},
+		{
+			name: "error func accepts",
+			options: []Option{WithRetryableErrorFunc(func(err error) bool {
+				return !errors.Is(err, errPermanent)
+			})},
+			err:           errNetwork,
+			expectedCalls: 3,
+		},
+		{
+			name:          "context error never retried",
+			err:           context.DeadlineExceeded,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+				return nil, tt.err
+			})
+			options := append([]Option{WithClient(mockDoer), WithRetry(3, 0)}, tt.options...)
+			client := MustNewClient("http://localhost:8081/api/", "", options...)
+
+			// Act
+			_, err := client.GetAccessKey(context.Background(), "1")
+
+			// Assert
+			assert.ErrorIs(t, err, DoOperationError)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Len(t, calls, tt.expectedCalls)
+		})
+	}
+}
+
+func TestWithRetry_WritesSentOnce(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(ctx context.Context, c *Client) error
+	}{
+		{
+			name: "PUT",
+			call: func(ctx context.Context, c *Client) error { return c.UpdateNameAccessKey(ctx, "1", "alice") },
+		},
+		{
+			name: "DELETE",
+			call: func(ctx context.Context, c *Client) error { return c.DeleteAccessKey(ctx, "1") },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+				return &contracts.Response{StatusCode: http.StatusServiceUnavailable}, nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithRetry(3, 0))
+
+			// Act
+			err := tt.call(context.Background(), client)
+
+			// Assert
+			assert.ErrorIs(t, err, ServiceUnavailableError)
+			assert.Len(t, calls, 1)
+		})
+	}
+}
+
+func TestWithRetry_CancelledDuringBackoff(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		cancel()
+		return &contracts.Response{StatusCode: http.StatusServiceUnavailable}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithRetry(3, time.Hour))
+
+	// Act
+	_, err := client.GetAccessKeys(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Len(t, calls, 1)
+}
+
+func TestWithRetry_StopsBeforeDeadline(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var calls []string
+	mockDoer := newSequenceMockDoer(t, &calls, &contracts.Response{StatusCode: http.StatusServiceUnavailable})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithRetry(3, time.Hour))
+
+	// Act
+	_, err := client.GetAccessKeys(ctx)
+
+	// Assert
+	assert.ErrorIs(t, err, ServiceUnavailableError)
+	assert.NotErrorIs(t, err, context.DeadlineExceeded)
+	assert.Len(t, calls, 1)
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	okKey := jsonResponse(http.StatusOK, types.AccessKey{ID: "1"})
+	retryTooManyRequests := RetryPolicyFunc(func(resp *Response, err error) bool {
+		return err == nil && resp.StatusCode == http.StatusTooManyRequests
+	})
+
+	tests := []struct {
+		name          string
+		policy        RetryPolicy
+		first         int
+		expectedCalls int
+	}{
+		{name: "policy retries 429", policy: retryTooManyRequests, first: http.StatusTooManyRequests, expectedCalls: 2},
+		{name: "policy replaces default statuses", policy: retryTooManyRequests, first: http.StatusServiceUnavailable, expectedCalls: 1},
+		{name: "nil policy ignored", policy: nil, first: http.StatusServiceUnavailable, expectedCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newSequenceMockDoer(t, &calls, &contracts.Response{StatusCode: tt.first}, okKey)
+			client := MustNewClient("http://localhost:8081/api/", "",
+				WithClient(mockDoer), WithRetry(3, 0), WithRetryPolicy(tt.policy))
+
+			// Act
+			_, _ = client.GetAccessKey(context.Background(), "1")
+
+			// Assert
+			assert.Len(t, calls, tt.expectedCalls)
+		})
+	}
+}
+
+func TestWithRetryPolicy_NonIdempotentNotRetried(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newSequenceMockDoer(t, &calls, &contracts.Response{StatusCode: http.StatusServiceUnavailable})
+	retryAll := RetryPolicyFunc(func(*Response, error) bool { return true })
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(mockDoer), WithRetry(3, 0), WithRetryPolicy(retryAll))
+
+	// Act
+	_, err := client.CreateAccessKey(context.Background(), &types.CreateAccessKey{Name: "alice"})
+
+	// Assert
+	assert.ErrorIs(t, err, ServiceUnavailableError)
+	assert.Equal(t, []string{"POST /access-keys"}, calls)
+}
+
+func TestWithRetry_InvalidOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxAttempts int
+		baseDelay   time.Duration
+	}{
+		{name: "zero attempts", maxAttempts: 0, baseDelay: time.Second},
+		{name: "negative delay", maxAttempts: 3, baseDelay: -time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClient("http://localhost:8081/api/", "", WithRetry(tt.maxAttempts, tt.baseDelay))
+
+			// Assert
+			assert.Nil(t, client)
+			var optionErr *OptionError
+			require.ErrorAs(t, err, &optionErr)
+			assert.ErrorIs(t, err, InvalidOptionError)
+		})
+	}
+}
+
+func TestRetryConfig_Backoff(t *testing.T) {
+	// Arrange
+	r := retryConfig{baseDelay: 100 * time.Millisecond}
+
+	// Act & Assert
+	assert.Equal(t, 100*time.Millisecond, r.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, r.backoff(2))
+	assert.Equal(t, 400*time.Millisecond, r.backoff(3))
+	assert.Equal(t, maxRetryDelay, r.backoff(20))
+	assert.Equal(t, maxRetryDelay, r.backoff(200))
+}
+
+func TestWithJitter(t *testing.T) {
+	// Arrange
+	const d = 100 * time.Millisecond
+
+	for range 1000 {
+		// Act
+		delay := withJitter(d)
+
+		// Assert
+		require.GreaterOrEqual(t, delay, d/2)
+		require.LessOrEqual(t, delay, d)
+	}
+	assert.Zero(t, withJitter(0))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"github.com/nepriyatelev/outline-client-go/internal/contracts"
 	"github.com/nepriyatelev/outline-client-go/outline/types"
@@ -11,11 +12,25 @@ import (
 
 // GetServerInfo retrieves information about the Outline server,
 // including name, version, and other metadata.
+// If the client was seeded with [WithInitialServerInfo] and no configuration
+// change has been made since, the seeded information is returned without a request.
 //
 // It returns [*ClientError] for unexpected HTTP status codes,
 // [*UnmarshalError] if JSON parsing fails,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) GetServerInfo(ctx context.Context) (*types.ServerInfoResponse, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	if info, ok := c.cachedServerInfo(); ok {
+		return info, nil
+	}
+
+	return c.fetchServerInfo(ctx)
+}
+
+// fetchServerInfo requests the server information, bypassing the server information cache.
+func (c *Client) fetchServerInfo(ctx context.Context) (*types.ServerInfoResponse, error) {
 	req := &contracts.Request{
 		Method:  http.MethodGet,
 		URL:     c.getServerInfoPath.String(),
@@ -23,18 +38,16 @@ func (c *Client) GetServerInfo(ctx context.Context) (*types.ServerInfoResponse,
 		Body:    nil,
 	}
 
-	c.logRequest(ctx, "GetServerInfo", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "GetServerInfo", req)
 	if err != nil {
 		return nil, errDoGetServerInfo(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return unmarshalJSONWithError[types.ServerInfoResponse](resp.Body)
+		return unmarshalJSONWithError[types.ServerInfoResponse](resp.Body, c.unmarshalOptions()...)
 	default:
-		return nil, errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return nil, c.finishClientError(ctx, errStatusCode(opGetServerInfo, resp.StatusCode, resp.Body))
 	}
 }
 
@@ -46,6 +59,9 @@ func (c *Client) GetServerInfo(ctx context.Context) (*types.ServerInfoResponse,
 // [*ClientError] with code 500 for internal server errors (e.g., network validation issues),
 // or [*DoError] if the HTTP request fails.
 func (c *Client) UpdateServerHostname(ctx context.Context, hostnameOrIP string) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	var reqBody struct {
 		Hostname string `json:"hostname"`
 	}
@@ -60,22 +76,21 @@ func (c *Client) UpdateServerHostname(ctx context.Context, hostnameOrIP string)
 		Body:    reqBodyBytes,
 	}
 
-	c.logRequest(ctx, "UpdateServerHostname", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "UpdateServerHostname", req)
 	if err != nil {
 		return errDoUpdateServerHostname(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusNoContent:
+		c.invalidateServerInfo()
 		return nil
 	case http.StatusBadRequest:
-		return errInvalidHostname(http.StatusBadRequest, hostnameOrIP)
+		return c.finishClientError(ctx, errInvalidHostname(opUpdateServerHostname, http.StatusBadRequest, hostnameOrIP))
 	case http.StatusInternalServerError:
-		return errInternalHostname(http.StatusInternalServerError, hostnameOrIP)
+		return c.finishClientError(ctx, errInternalHostname(opUpdateServerHostname, http.StatusInternalServerError, hostnameOrIP))
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opUpdateServerHostname, resp.StatusCode, resp.Body))
 	}
 }
 
@@ -86,6 +101,9 @@ func (c *Client) UpdateServerHostname(ctx context.Context, hostnameOrIP string)
 // [*ClientError] with code 409 if the port is already in use by another service,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) UpdatePortNewAccessKeys(ctx context.Context, port uint16) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	var reqBody struct {
 		Port uint16 `json:"port"`
 	}
@@ -100,23 +118,63 @@ func (c *Client) UpdatePortNewAccessKeys(ctx context.Context, port uint16) error
 		Body:    reqBodyBytes,
 	}
 
-	c.logRequest(ctx, "UpdatePortNewAccessKeys", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "UpdatePortNewAccessKeys", req)
 	if err != nil {
 		return errDoUpdatePortNewAccessKeys(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusNoContent:
+		c.invalidateServerInfo()
 		return nil
 	case http.StatusBadRequest:
-		return errInvalidPort(http.StatusBadRequest, port)
+		return c.finishClientError(ctx, errInvalidPort(opUpdatePortNewAccessKeys, http.StatusBadRequest, port))
 	case http.StatusConflict:
-		return errPortAlreadyInUse(http.StatusConflict, port)
+		return c.finishClientError(ctx, errPortAlreadyInUse(opUpdatePortNewAccessKeys, http.StatusConflict, port))
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opUpdatePortNewAccessKeys, resp.StatusCode, resp.Body))
+	}
+}
+
+// UpdatePortNewAccessKeysVerified changes the default port for new access keys like
+// [Client.UpdatePortNewAccessKeys] and then reads the server information back, bypassing the cache,
+// to confirm the port took effect. Use [Client.UpdatePortNewAccessKeys] when the extra request is not wanted.
+//
+// It returns the errors of [Client.UpdatePortNewAccessKeys] and [Client.GetServerInfo],
+// or [*VerificationError] if the server reports a different port.
+func (c *Client) UpdatePortNewAccessKeysVerified(ctx context.Context, port uint16) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	if err := c.UpdatePortNewAccessKeys(ctx, port); err != nil {
+		return err
+	}
+
+	info, err := c.fetchServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	if info.PortForNewAccessKeys != int(port) {
+		return errVerification("", "portForNewAccessKeys",
+			strconv.Itoa(int(port)), strconv.Itoa(info.PortForNewAccessKeys))
 	}
+
+	return nil
+}
+
+// GetPortForNewAccessKeys returns the default port for new access keys reported by [Client.GetServerInfo].
+//
+// It returns the errors of [Client.GetServerInfo].
+func (c *Client) GetPortForNewAccessKeys(ctx context.Context) (int, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	info, err := c.GetServerInfo(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return info.PortForNewAccessKeys, nil
 }
 
 // UpdateServerName renames the server to the specified name.
@@ -124,6 +182,9 @@ func (c *Client) UpdatePortNewAccessKeys(ctx context.Context, port uint16) error
 // It returns [*ClientError] with code 400 if the name is invalid,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) UpdateServerName(ctx context.Context, name string) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	var reqBody struct {
 		Name string `json:"name"`
 	}
@@ -138,20 +199,19 @@ func (c *Client) UpdateServerName(ctx context.Context, name string) error {
 		Body:    reqBodyBytes,
 	}
 
-	c.logRequest(ctx, "UpdateServerName", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "UpdateServerName", req)
 	if err != nil {
 		return errDoUpdateServerName(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusNoContent:
+		c.invalidateServerInfo()
 		return nil
 	case http.StatusBadRequest:
-		return errInvalidServerName(http.StatusBadRequest, name)
+		return c.finishClientError(ctx, errInvalidServerName(opUpdateServerName, http.StatusBadRequest, name))
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opUpdateServerName, resp.StatusCode, resp.Body))
 	}
 }
 
@@ -161,6 +221,9 @@ func (c *Client) UpdateServerName(ctx context.Context, name string) error {
 // [*UnmarshalError] if JSON parsing fails,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) GetMetricsEnabled(ctx context.Context) (*types.MetricsEnabled, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	req := &contracts.Request{
 		Method:  http.MethodGet,
 		URL:     c.getMetricsEnabledPath.String(),
@@ -168,26 +231,29 @@ func (c *Client) GetMetricsEnabled(ctx context.Context) (*types.MetricsEnabled,
 		Body:    nil,
 	}
 
-	c.logRequest(ctx, "GetMetricsEnabled", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "GetMetricsEnabled", req)
 	if err != nil {
 		return nil, errDoGetMetricsEnabled(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusOK:
-		return unmarshalJSONWithError[types.MetricsEnabled](resp.Body)
+		return unmarshalJSONWithError[types.MetricsEnabled](resp.Body, c.unmarshalOptions()...)
 	default:
-		return nil, errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return nil, c.finishClientError(ctx, errStatusCode(opGetMetricsEnabled, resp.StatusCode, resp.Body))
 	}
 }
 
 // UpdateMetricsEnabled enables or disables sharing of metrics.
 //
 // It returns [*ClientError] with code 400 if the request body is invalid,
+// [*ClientError] with code 404 wrapping [EndpointNotFoundError] if the secret or path is wrong,
+// [*ClientError] for other unexpected HTTP status codes,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) UpdateMetricsEnabled(ctx context.Context, enabled bool) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	var reqBody types.MetricsEnabled
 	reqBody.Enabled = enabled
 
@@ -200,29 +266,33 @@ func (c *Client) UpdateMetricsEnabled(ctx context.Context, enabled bool) error {
 		Body:    reqBodyBytes,
 	}
 
-	c.logRequest(ctx, "UpdateMetricsEnabled", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "UpdateMetricsEnabled", req)
 	if err != nil {
 		return errDoUpdateMetricsEnabled(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusNoContent:
+		c.invalidateServerInfo()
 		return nil
 	case http.StatusBadRequest:
-		return errInvalidRequest(http.StatusBadRequest, string(resp.Body))
+		return c.finishClientError(ctx, errInvalidRequest(opUpdateMetricsEnabled, http.StatusBadRequest, string(resp.Body)))
+	case http.StatusNotFound:
+		return c.finishClientError(ctx, errEndpointNotFound(opUpdateMetricsEnabled, http.StatusNotFound, resp.Body))
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opUpdateMetricsEnabled, resp.StatusCode, resp.Body))
 	}
 }
 
-// UpdateKeyLimitBytes sets a server-wide data limit for newly created access keys.
-// This limit applies to all new access keys that will be created after this call.
+// UpdateKeyLimitBytes sets a server-wide access key data limit.
+// This limit applies to every access key that has no limit of its own, including existing keys.
 //
 // It returns [*ClientError] with code 400 if the data limit value is invalid,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) UpdateKeyLimitBytes(ctx context.Context, bytes uint64) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	var reqBody struct {
 		Limit types.Limit `json:"limit"`
 	}
@@ -237,29 +307,31 @@ func (c *Client) UpdateKeyLimitBytes(ctx context.Context, bytes uint64) error {
 		Body:    reqBodyBytes,
 	}
 
-	c.logRequest(ctx, "UpdateKeyLimitBytes", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "UpdateKeyLimitBytes", req)
 	if err != nil {
 		return errDoUpdateKeyLimitBytes(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusNoContent:
+		c.invalidateServerInfo()
 		return nil
 	case http.StatusBadRequest:
-		return errInvalidDataLimit(http.StatusBadRequest, bytes)
+		return c.finishClientError(ctx, errInvalidDataLimit(opUpdateKeyLimitBytes, http.StatusBadRequest, bytes))
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opUpdateKeyLimitBytes, resp.StatusCode, resp.Body))
 	}
 }
 
 // DeleteKeyLimitBytes removes the server-wide data limit for access keys.
-// After this call, newly created access keys will not have a data limit.
+// After this call, only access keys with a limit of their own are limited.
 //
 // It returns [*ClientError] for unexpected HTTP status codes,
 // or [*DoError] if the HTTP request fails.
 func (c *Client) DeleteKeyLimitBytes(ctx context.Context) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
 	req := &contracts.Request{
 		Method:  http.MethodDelete,
 		URL:     c.deleteServerAccessKeyDataLimitPath.String(),
@@ -267,17 +339,43 @@ func (c *Client) DeleteKeyLimitBytes(ctx context.Context) error {
 		Body:    nil,
 	}
 
-	c.logRequest(ctx, "DeleteKeyLimitBytes", req)
-
-	resp, err := c.doer.Do(ctx, req)
+	resp, err := c.do(ctx, "DeleteKeyLimitBytes", req)
 	if err != nil {
 		return errDoDeleteKeyLimitBytes(err)
 	}
 
 	switch resp.StatusCode {
 	case http.StatusNoContent:
+		c.invalidateServerInfo()
 		return nil
 	default:
-		return errUnexpectedStatusCode(resp.StatusCode, resp.Body)
+		return c.finishClientError(ctx, errStatusCode(opDeleteKeyLimitBytes, resp.StatusCode, resp.Body))
 	}
 }
+
+// SetServerDataLimit sets the server-wide access key data limit with PUT /server/access-key-data-limit.
+// Unlike [Client.UpdateDataLimitAccessKey], which limits a single key, it applies to every key
+// that has no limit of its own. It is equivalent to [Client.UpdateKeyLimitBytes].
+//
+// It returns [*ClientError] with code 400 wrapping [InvalidDataLimitError] if the limit is rejected,
+// [*ClientError] for other unexpected HTTP status codes,
+// or [*DoError] if the HTTP request fails.
+func (c *Client) SetServerDataLimit(ctx context.Context, bytes uint64) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	return c.UpdateKeyLimitBytes(ctx, bytes)
+}
+
+// RemoveServerDataLimit removes the server-wide access key data limit with
+// DELETE /server/access-key-data-limit. Per-key limits set with [Client.UpdateDataLimitAccessKey]
+// are kept. It is equivalent to [Client.DeleteKeyLimitBytes].
+//
+// It returns [*ClientError] for unexpected HTTP status codes,
+// or [*DoError] if the HTTP request fails.
+func (c *Client) RemoveServerDataLimit(ctx context.Context) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	return c.DeleteKeyLimitBytes(ctx)
+}
@@ -0,0 +1,36 @@
+package outline
+
+import (
+	"slices"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// === Server Information Cache ===
+
+// cachedServerInfo returns a copy of the cached server information, if any.
+func (c *Client) cachedServerInfo() (*types.ServerInfoResponse, bool) {
+	info := c.serverInfo.Load()
+	if info == nil {
+		return nil, false
+	}
+	return cloneServerInfo(info), true
+}
+
+// cloneServerInfo returns a copy of info that shares no memory with it.
+func cloneServerInfo(info *types.ServerInfoResponse) *types.ServerInfoResponse {
+	clone := *info
+	clone.SupportedEncryptionMethods = slices.Clone(info.SupportedEncryptionMethods)
+	if info.AccessKeyDataLimit != nil {
+		limit := *info.AccessKeyDataLimit
+		clone.AccessKeyDataLimit = &limit
+	}
+	return &clone
+}
+
+// invalidateServerInfo drops the cached server information
+// after a call that changes the server configuration.
+func (c *Client) invalidateServerInfo() {
+	c.serverInfo.Store(nil)
+	c.accessURLHost.Store(nil)
+}
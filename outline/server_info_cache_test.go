@@ -0,0 +1,147 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WithInitialServerInfo Tests ===
+
+func TestWithInitialServerInfo_AnsweredFromCache(t *testing.T) {
+	// Arrange
+	seed := &types.ServerInfoResponse{Name: "Seeded", ServerID: "server-1", PortForNewAccessKeys: 8000}
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithInitialServerInfo(seed))
+
+	// Act
+	result, err := client.GetServerInfo(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, seed, result)
+	assert.NotSame(t, seed, result)
+	assert.Empty(t, calls)
+}
+
+func TestWithInitialServerInfo_CopyIsolated(t *testing.T) {
+	// Arrange
+	seed := &types.ServerInfoResponse{
+		Name:                       "Seeded",
+		AccessKeyDataLimit:         &types.Limit{Bytes: 1000},
+		SupportedEncryptionMethods: []string{types.MethodAES256GCM},
+	}
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(NewMockDoer(t)), WithInitialServerInfo(seed))
+	ctx := context.Background()
+
+	// Act
+	first, firstErr := client.GetServerInfo(ctx)
+	require.NoError(t, firstErr)
+	first.AccessKeyDataLimit.Bytes = 1
+	first.SupportedEncryptionMethods[0] = "mutated"
+	second, secondErr := client.GetServerInfo(ctx)
+
+	// Assert
+	require.NoError(t, secondErr)
+	assert.Equal(t, &types.Limit{Bytes: 1000}, second.AccessKeyDataLimit)
+	assert.Equal(t, []string{types.MethodAES256GCM}, second.SupportedEncryptionMethods)
+	assert.Equal(t, &types.Limit{Bytes: 1000}, seed.AccessKeyDataLimit)
+}
+
+func TestWithInitialServerInfo_InvalidatedByMutation(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(ctx context.Context, c *Client) error
+	}{
+		{
+			name:   "UpdateServerHostname",
+			mutate: func(ctx context.Context, c *Client) error { return c.UpdateServerHostname(ctx, "example.com") },
+		},
+		{
+			name:   "UpdatePortNewAccessKeys",
+			mutate: func(ctx context.Context, c *Client) error { return c.UpdatePortNewAccessKeys(ctx, 9000) },
+		},
+		{
+			name:   "UpdateServerName",
+			mutate: func(ctx context.Context, c *Client) error { return c.UpdateServerName(ctx, "Renamed") },
+		},
+		{
+			name:   "UpdateMetricsEnabled",
+			mutate: func(ctx context.Context, c *Client) error { return c.UpdateMetricsEnabled(ctx, true) },
+		},
+		{
+			name:   "UpdateKeyLimitBytes",
+			mutate: func(ctx context.Context, c *Client) error { return c.UpdateKeyLimitBytes(ctx, 1024) },
+		},
+		{
+			name:   "DeleteKeyLimitBytes",
+			mutate: func(ctx context.Context, c *Client) error { return c.DeleteKeyLimitBytes(ctx) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			fresh := types.ServerInfoResponse{Name: "Fresh", ServerID: "server-1"}
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				if req.Method == http.MethodGet {
+					return jsonResponse(http.StatusOK, fresh), nil
+				}
+				return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "",
+				WithClient(mockDoer),
+				WithInitialServerInfo(&types.ServerInfoResponse{Name: "Seeded", ServerID: "server-1"}),
+			)
+			ctx := context.Background()
+
+			// Act
+			require.NoError(t, tt.mutate(ctx, client))
+			result, err := client.GetServerInfo(ctx)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, &fresh, result)
+			assert.Len(t, calls, 2)
+		})
+	}
+}
+
+func TestWithInitialServerInfo_KeptOnFailedMutation(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusBadRequest}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(mockDoer),
+		WithInitialServerInfo(&types.ServerInfoResponse{Name: "Seeded"}),
+	)
+	ctx := context.Background()
+
+	// Act
+	mutateErr := client.UpdateServerName(ctx, "")
+	result, err := client.GetServerInfo(ctx)
+
+	// Assert
+	require.Error(t, mutateErr)
+	require.NoError(t, err)
+	assert.Equal(t, "Seeded", result.Name)
+}
+
+func TestWithInitialServerInfo_Nil(t *testing.T) {
+	// Arrange & Act
+	client := MustNewClient("http://localhost:8081/api/", "", WithInitialServerInfo(nil))
+
+	// Assert
+	_, ok := client.cachedServerInfo()
+	assert.False(t, ok)
+}
@@ -0,0 +1,26 @@
+package outline
+
+import "context"
+
+// serverNameKey is the context key of the server identifier set by [ContextWithServerName].
+type serverNameKey struct{}
+
+// ContextWithServerName returns a copy of ctx carrying the identifier of the server a call targets.
+// [*ClientError] values returned by calls made with the context report it through [ClientError.ServerName]
+// and in their message, overriding the name set by [WithClientName]. An empty name is ignored.
+func ContextWithServerName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, serverNameKey{}, name)
+}
+
+// serverName returns the identifier of the server targeted by a call made with ctx.
+func (c *Client) serverName(ctx context.Context) string {
+	if name, ok := ctx.Value(serverNameKey{}).(string); ok && name != "" {
+		return name
+	}
+	return c.clientName
+}
+
+// withServerName records the identifier of the server targeted by a call made with ctx in err.
+func (c *Client) withServerName(ctx context.Context, err *ClientError) {
+	err.serverName = c.serverName(ctx)
+}
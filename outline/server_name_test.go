@@ -0,0 +1,180 @@
+package outline
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === Server Name Tests ===
+
+func TestClientError_ServerName(t *testing.T) {
+	tests := []struct {
+		name         string
+		options      []Option
+		ctx          context.Context
+		expectedName string
+	}{
+		{name: "not configured", ctx: context.Background()},
+		{name: "client name", options: []Option{WithClientName("eu-1")}, ctx: context.Background(), expectedName: "eu-1"},
+		{name: "context name", ctx: ContextWithServerName(context.Background(), "us-2"), expectedName: "us-2"},
+		{
+			name:         "context overrides client name",
+			options:      []Option{WithClientName("eu-1")},
+			ctx:          ContextWithServerName(context.Background(), "us-2"),
+			expectedName: "us-2",
+		},
+		{
+			name:         "empty context name ignored",
+			options:      []Option{WithClientName("eu-1")},
+			ctx:          ContextWithServerName(context.Background(), ""),
+			expectedName: "eu-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoerAccessKey(t, &contracts.Response{StatusCode: http.StatusNotFound}, nil, nil)
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+
+			// Act
+			_, err := client.GetAccessKey(tt.ctx, "1")
+
+			// Assert
+			var clientErr *ClientError
+			require.ErrorAs(t, err, &clientErr)
+			assert.ErrorIs(t, err, AccessKeyNotFoundError)
+			assert.Equal(t, tt.expectedName, clientErr.ServerName())
+			if tt.expectedName == "" {
+				assert.NotContains(t, err.Error(), "server:")
+				return
+			}
+			assert.Contains(t, err.Error(), "; server: "+tt.expectedName+"; operation: "+opGetAccessKey)
+		})
+	}
+}
+
+func TestClientError_ServerNameUnexpectedStatus(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusInternalServerError}, nil, nil)
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithClientName("eu-1"))
+
+	// Act
+	err := client.UpdateServerName(context.Background(), "name")
+
+	// Assert
+	var clientErr *ClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, "eu-1", clientErr.ServerName())
+	assert.Contains(t, err.Error(), "server: eu-1")
+}
+
+func TestClientError_MasksSecret(t *testing.T) {
+	const secret = "AbC123sEcReT"
+	body := []byte(`{"code":"BadRequest","message":"invalid request to /api/` + secret + `/server"}`)
+	ignore := func(_ any, err error) error { return err }
+	operations := map[string]func(ctx context.Context, c *Client) error{
+		"GetServerInfo":           func(ctx context.Context, c *Client) error { return ignore(c.GetServerInfo(ctx)) },
+		"UpdateServerHostname":    func(ctx context.Context, c *Client) error { return c.UpdateServerHostname(ctx, "example.com") },
+		"UpdatePortNewAccessKeys": func(ctx context.Context, c *Client) error { return c.UpdatePortNewAccessKeys(ctx, 8388) },
+		"UpdateServerName":        func(ctx context.Context, c *Client) error { return c.UpdateServerName(ctx, "name") },
+		"GetMetricsEnabled":       func(ctx context.Context, c *Client) error { return ignore(c.GetMetricsEnabled(ctx)) },
+		"UpdateMetricsEnabled":    func(ctx context.Context, c *Client) error { return c.UpdateMetricsEnabled(ctx, true) },
+		"UpdateKeyLimitBytes":     func(ctx context.Context, c *Client) error { return c.UpdateKeyLimitBytes(ctx, 1) },
+		"DeleteKeyLimitBytes":     func(ctx context.Context, c *Client) error { return c.DeleteKeyLimitBytes(ctx) },
+		"CreateAccessKey": func(ctx context.Context, c *Client) error {
+			return ignore(c.CreateAccessKey(ctx, &types.CreateAccessKey{}))
+		},
+		"CreateAccessKeyWithID": func(ctx context.Context, c *Client) error {
+			return ignore(c.CreateAccessKeyWithID(ctx, "1", &types.CreateAccessKey{}))
+		},
+		"GetAccessKeys": func(ctx context.Context, c *Client) error { return ignore(c.GetAccessKeys(ctx)) },
+		"GetAccessKey":  func(ctx context.Context, c *Client) error { return ignore(c.GetAccessKey(ctx, "1")) },
+		"UpdateAccessKey": func(ctx context.Context, c *Client) error {
+			return ignore(c.UpdateAccessKey(ctx, "1", &types.AccessKey{ID: "1"}))
+		},
+		"DeleteAccessKey":          func(ctx context.Context, c *Client) error { return c.DeleteAccessKey(ctx, "1") },
+		"UpdateNameAccessKey":      func(ctx context.Context, c *Client) error { return c.UpdateNameAccessKey(ctx, "1", "name") },
+		"UpdateDataLimitAccessKey": func(ctx context.Context, c *Client) error { return c.UpdateDataLimitAccessKey(ctx, "1", 1) },
+		"DeleteDataLimitAccessKey": func(ctx context.Context, c *Client) error { return c.DeleteDataLimitAccessKey(ctx, "1") },
+		"GetMetricsTransfer":       func(ctx context.Context, c *Client) error { return ignore(c.GetMetricsTransfer(ctx)) },
+		"GetExperimentalMetrics": func(ctx context.Context, c *Client) error {
+			return ignore(c.GetExperimentalMetrics(ctx, time.Hour))
+		},
+		"GetExperimentalMetricsRaw": func(ctx context.Context, c *Client) error {
+			return ignore(c.GetExperimentalMetricsRaw(ctx, time.Hour))
+		},
+		"DetectClockSkew": func(ctx context.Context, c *Client) error { return ignore(c.DetectClockSkew(ctx)) },
+	}
+	statusCodes := []int{
+		http.StatusBadRequest, http.StatusNotFound, http.StatusConflict,
+		http.StatusInternalServerError, http.StatusServiceUnavailable,
+	}
+
+	for name, call := range operations {
+		for _, statusCode := range statusCodes {
+			t.Run(fmt.Sprintf("%s/%d", name, statusCode), func(t *testing.T) {
+				// Arrange
+				mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+					return &contracts.Response{StatusCode: statusCode, Body: body}, nil
+				})
+				client := MustNewClient("http://localhost:8081/api/", secret, WithClient(mockDoer))
+
+				// Act
+				err := call(context.Background(), client)
+
+				// Assert
+				var clientErr *ClientError
+				require.ErrorAs(t, err, &clientErr)
+				assert.NotContains(t, err.Error(), secret)
+				assert.NotContains(t, clientErr.ServerMessage(), secret)
+				for _, apiErr := range clientErr.APIErrors() {
+					assert.NotContains(t, apiErr.Message, secret)
+				}
+			})
+		}
+	}
+}
+
+func TestDoError_MasksSecret(t *testing.T) {
+	// Arrange
+	const secret = "AbC123sEcReT"
+	doerErr := fmt.Errorf(`Get "http://localhost:8081/api/%s/server": dial tcp: connection refused`, secret)
+	mockDoer := newMockDoer(t, nil, doerErr, nil)
+	client := MustNewClient("http://localhost:8081/api/", secret, WithClient(mockDoer))
+
+	// Act
+	_, err := client.GetServerInfo(context.Background())
+
+	// Assert
+	var doErr *DoError
+	require.ErrorAs(t, err, &doErr)
+	assert.ErrorIs(t, err, doerErr)
+	assert.NotContains(t, err.Error(), secret)
+	assert.Contains(t, err.Error(), "/api/*****/server")
+}
+
+func TestClientError_MasksSecretInServerMessage(t *testing.T) {
+	// Arrange
+	const secret = "AbC123sEcReT"
+	body := []byte(`{"code":"BadRequest","message":"invalid request to /api/` + secret + `/server"}`)
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusBadRequest, Body: body}, nil, nil)
+	client := MustNewClient("http://localhost:8081/api/", secret, WithClient(mockDoer))
+
+	// Act
+	_, err := client.GetServerInfo(context.Background())
+
+	// Assert
+	var clientErr *ClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Contains(t, err.Error(), "/api/*****/server")
+	assert.Equal(t, "BadRequest: invalid request to /api/*****/server", clientErr.ServerMessage())
+}
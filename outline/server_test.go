@@ -93,6 +93,24 @@ func TestGetServerInfo_Success(t *testing.T) {
 	assert.Equal(t, http.MethodGet, req.Method)
 }
 
+func TestGetServerInfo_UnmodeledFields(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{
+		StatusCode: http.StatusOK,
+		Body:       []byte(`{"name":"Test Server","serverId":"server-123","experimental":{"asn":true}}`),
+	}, nil, nil)
+
+	client := createTestClient(mockDoer)
+
+	// Act
+	result, err := client.GetServerInfo(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "Test Server", result.Name)
+	assert.Equal(t, map[string]json.RawMessage{"experimental": json.RawMessage(`{"asn":true}`)}, result.Extra())
+}
+
 func TestGetServerInfo_DoerError(t *testing.T) {
 	// Arrange
 	networkError := errors.New("network error")
@@ -136,6 +154,28 @@ func TestGetServerInfo_InvalidJSON(t *testing.T) {
 	assert.ErrorIs(t, err, UnmarshalFailedError)
 }
 
+func TestGetServerInfo_EmptyBody(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{
+		StatusCode: http.StatusOK,
+		Body:       nil,
+	}, nil, nil)
+
+	client := createTestClient(mockDoer)
+	ctx := context.Background()
+
+	// Act
+	result, err := client.GetServerInfo(ctx)
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	var ue *UnmarshalError
+	assert.ErrorAs(t, err, &ue)
+	assert.ErrorIs(t, err, UnmarshalFailedError)
+	assert.ErrorIs(t, err, UnmarshalEmptyBodyError)
+}
+
 func TestGetServerInfo_NotFound(t *testing.T) {
 	// Arrange
 	mockDoer := newMockDoer(t, &contracts.Response{
@@ -468,6 +508,92 @@ func TestUpdatePortNewAccessKeys_UnexpectedError(t *testing.T) {
 	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
 }
 
+// === UpdatePortNewAccessKeysVerified Tests ===
+
+func TestUpdatePortNewAccessKeysVerified(t *testing.T) {
+	tests := []struct {
+		name         string
+		reportedPort int
+		expectErr    bool
+	}{
+		{name: "port took effect", reportedPort: 8388},
+		{name: "port differs", reportedPort: 443, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(req *contracts.Request) (*contracts.Response, error) {
+				if req.Method == http.MethodGet {
+					return jsonResponse(http.StatusOK, types.ServerInfoResponse{PortForNewAccessKeys: tt.reportedPort}), nil
+				}
+				return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer),
+				WithInitialServerInfo(&types.ServerInfoResponse{PortForNewAccessKeys: 1}))
+
+			// Act
+			err := client.UpdatePortNewAccessKeysVerified(context.Background(), 8388)
+
+			// Assert
+			assert.Equal(t, []string{"PUT /server/port-for-new-access-keys", "GET /server"}, calls)
+			if tt.expectErr {
+				var verErr *VerificationError
+				require.ErrorAs(t, err, &verErr)
+				assert.ErrorIs(t, err, VerificationFailedError)
+				assert.Contains(t, err.Error(), "expected: 8388; actual: 443")
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestUpdatePortNewAccessKeysVerified_UpdateFails(t *testing.T) {
+	// Arrange
+	var calls []string
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusConflict}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	err := client.UpdatePortNewAccessKeysVerified(context.Background(), 8388)
+
+	// Assert
+	assert.ErrorIs(t, err, PortAlreadyInUseError)
+	assert.Equal(t, []string{"PUT /server/port-for-new-access-keys"}, calls)
+}
+
+// === GetPortForNewAccessKeys Tests ===
+
+func TestGetPortForNewAccessKeys(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, jsonResponse(http.StatusOK, types.ServerInfoResponse{PortForNewAccessKeys: 8388}), nil, nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	port, err := client.GetPortForNewAccessKeys(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, 8388, port)
+}
+
+func TestGetPortForNewAccessKeys_Error(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{StatusCode: http.StatusInternalServerError}, nil, nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	port, err := client.GetPortForNewAccessKeys(context.Background())
+
+	// Assert
+	assert.Zero(t, port)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+}
+
 // === UpdateServerName Tests ===
 
 func TestUpdateServerName_Success(t *testing.T) {
@@ -612,6 +738,36 @@ func TestGetMetricsEnabled_DisabledMetrics(t *testing.T) {
 	assert.False(t, result.Enabled)
 }
 
+func TestGetMetricsEnabled_WireFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected bool
+	}{
+		{name: "enabled", body: `{"metricsEnabled":true}`, expected: true},
+		{name: "disabled", body: `{"metricsEnabled":false}`, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoer(t, &contracts.Response{
+				StatusCode: http.StatusOK,
+				Body:       []byte(tt.body),
+			}, nil, nil)
+			client := createTestClient(mockDoer)
+
+			// Act
+			result, err := client.GetMetricsEnabled(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			assert.Equal(t, tt.expected, result.Enabled)
+		})
+	}
+}
+
 func TestGetMetricsEnabled_InvalidJSON(t *testing.T) {
 	// Arrange
 	mockDoer := newMockDoer(t, &contracts.Response{
@@ -697,6 +853,7 @@ func TestUpdateMetricsEnabled_EnableMetrics(t *testing.T) {
 	assert.Equal(t, http.MethodPut, req.Method)
 
 	// Verify request body
+	assert.JSONEq(t, `{"metricsEnabled":true}`, string(req.Body))
 	var reqBody types.MetricsEnabled
 	err = json.Unmarshal(req.Body, &reqBody)
 	require.NoError(t, err)
@@ -769,6 +926,30 @@ func TestUpdateMetricsEnabled_BadRequest(t *testing.T) {
 	assert.ErrorIs(t, err, InvalidRequestError)
 }
 
+func TestUpdateMetricsEnabled_NotFound(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoer(t, &contracts.Response{
+		StatusCode: http.StatusNotFound,
+		Body:       []byte(`{"code":"ResourceNotFound","message":"/wrong-secret/metrics/enabled does not exist"}`),
+	}, nil, nil)
+
+	client := createTestClient(mockDoer)
+	ctx := context.Background()
+
+	// Act
+	err := client.UpdateMetricsEnabled(ctx, true)
+
+	// Assert
+	var clientErr *ClientError
+	require.ErrorAs(t, err, &clientErr)
+	assert.Equal(t, http.StatusNotFound, clientErr.StatusCode())
+	assert.Equal(t, "update metrics enabled", clientErr.Operation())
+	assert.Equal(t, "ResourceNotFound", clientErr.APIErrors()[0].Code)
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, EndpointNotFoundError)
+	assert.NotErrorIs(t, err, UnexpectedStatusCodeError)
+}
+
 func TestUpdateMetricsEnabled_UnexpectedStatus(t *testing.T) {
 	// Arrange
 	mockDoer := newMockDoer(t, &contracts.Response{
@@ -1021,3 +1202,189 @@ func TestDeleteKeyLimitBytes_UnexpectedStatus(t *testing.T) {
 	assert.ErrorIs(t, err, ClientOutlineError)
 	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
 }
+
+func TestServerLimitMethods_Path(t *testing.T) {
+	tests := []struct {
+		name           string
+		call           func(ctx context.Context, c *Client) error
+		expectedMethod string
+	}{
+		{
+			name:           "UpdateKeyLimitBytes",
+			call:           func(ctx context.Context, c *Client) error { return c.UpdateKeyLimitBytes(ctx, 1024) },
+			expectedMethod: http.MethodPut,
+		},
+		{
+			name:           "DeleteKeyLimitBytes",
+			call:           func(ctx context.Context, c *Client) error { return c.DeleteKeyLimitBytes(ctx) },
+			expectedMethod: http.MethodDelete,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+				return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer))
+
+			// Act
+			err := tt.call(context.Background(), client)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, []string{tt.expectedMethod + " /server/access-key-data-limit"}, calls)
+		})
+	}
+}
+
+func TestServerMethods_StatusErrorSentinels(t *testing.T) {
+	tests := []struct {
+		name              string
+		call              func(ctx context.Context, c *Client) error
+		statusCode        int
+		expectedSentinel  error
+		expectedOperation string
+	}{
+		{
+			name:              "hostname bad request",
+			call:              func(ctx context.Context, c *Client) error { return c.UpdateServerHostname(ctx, "bad@host") },
+			statusCode:        http.StatusBadRequest,
+			expectedSentinel:  InvalidHostnameError,
+			expectedOperation: opUpdateServerHostname,
+		},
+		{
+			name:              "hostname internal error",
+			call:              func(ctx context.Context, c *Client) error { return c.UpdateServerHostname(ctx, "example.com") },
+			statusCode:        http.StatusInternalServerError,
+			expectedSentinel:  InternalHostNameError,
+			expectedOperation: opUpdateServerHostname,
+		},
+		{
+			name:              "port bad request",
+			call:              func(ctx context.Context, c *Client) error { return c.UpdatePortNewAccessKeys(ctx, 8388) },
+			statusCode:        http.StatusBadRequest,
+			expectedSentinel:  InvalidPortError,
+			expectedOperation: opUpdatePortNewAccessKeys,
+		},
+		{
+			name:              "port conflict",
+			call:              func(ctx context.Context, c *Client) error { return c.UpdatePortNewAccessKeys(ctx, 8388) },
+			statusCode:        http.StatusConflict,
+			expectedSentinel:  PortAlreadyInUseError,
+			expectedOperation: opUpdatePortNewAccessKeys,
+		},
+		{
+			name:              "name bad request",
+			call:              func(ctx context.Context, c *Client) error { return c.UpdateServerName(ctx, "My Server") },
+			statusCode:        http.StatusBadRequest,
+			expectedSentinel:  InvalidServerNameError,
+			expectedOperation: opUpdateServerName,
+		},
+		{
+			name:              "metrics bad request",
+			call:              func(ctx context.Context, c *Client) error { return c.UpdateMetricsEnabled(ctx, true) },
+			statusCode:        http.StatusBadRequest,
+			expectedSentinel:  InvalidRequestError,
+			expectedOperation: opUpdateMetricsEnabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoer(t, &contracts.Response{StatusCode: tt.statusCode}, nil, nil)
+			client := createTestClient(mockDoer)
+
+			// Act
+			err := tt.call(context.Background(), client)
+
+			// Assert
+			require.ErrorIs(t, err, tt.expectedSentinel)
+			assert.ErrorIs(t, err, ClientOutlineError)
+			var clientErr *ClientError
+			require.ErrorAs(t, err, &clientErr)
+			assert.Equal(t, tt.statusCode, clientErr.StatusCode())
+			assert.Equal(t, tt.expectedOperation, clientErr.Operation())
+		})
+	}
+}
+
+// === SetServerDataLimit / RemoveServerDataLimit Tests ===
+
+func TestSetServerDataLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		expectedErr error
+	}{
+		{name: "no content", statusCode: http.StatusNoContent},
+		{name: "invalid limit", statusCode: http.StatusBadRequest, expectedErr: InvalidDataLimitError},
+		{name: "unexpected status", statusCode: http.StatusInternalServerError, expectedErr: UnexpectedStatusCodeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var req *contracts.Request
+			mockDoer := newMockDoer(t, &contracts.Response{StatusCode: tt.statusCode}, nil, &req)
+			client := createTestClient(mockDoer)
+
+			// Act
+			err := client.SetServerDataLimit(context.Background(), 5000)
+
+			// Assert
+			require.NotNil(t, req)
+			assert.Equal(t, http.MethodPut, req.Method)
+			assert.Equal(t, "http://localhost:8081/api/server/access-key-data-limit", req.URL)
+			assert.JSONEq(t, `{"limit":{"bytes":5000}}`, string(req.Body))
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			var clientErr *ClientError
+			require.ErrorAs(t, err, &clientErr)
+			assert.Equal(t, tt.statusCode, clientErr.StatusCode())
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestRemoveServerDataLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		statusCode  int
+		expectedErr error
+	}{
+		{name: "no content", statusCode: http.StatusNoContent},
+		{name: "unexpected status", statusCode: http.StatusBadRequest, expectedErr: UnexpectedStatusCodeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var req *contracts.Request
+			mockDoer := newMockDoer(t, &contracts.Response{StatusCode: tt.statusCode}, nil, &req)
+			client := createTestClient(mockDoer)
+
+			// Act
+			err := client.RemoveServerDataLimit(context.Background())
+
+			// Assert
+			require.NotNil(t, req)
+			assert.Equal(t, http.MethodDelete, req.Method)
+			assert.Equal(t, "http://localhost:8081/api/server/access-key-data-limit", req.URL)
+			assert.Empty(t, req.Body)
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			var clientErr *ClientError
+			require.ErrorAs(t, err, &clientErr)
+			assert.Equal(t, tt.statusCode, clientErr.StatusCode())
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+)
+
+// doShared sends req through [Client.send], sharing the round trip with concurrent
+// calls for the same method and URL. Every caller receives its own copy of the response.
+//
+// The shared request runs under the context of the caller that started it. If that context ends
+// the request while the context of a waiting caller is still alive, the waiting caller sends the request itself.
+func (c *Client) doShared(ctx context.Context, methodName string, req *contracts.Request) (*contracts.Response, error) {
+	resultCh := c.flights.DoChan(req.Method+" "+req.URL, func() (any, error) {
+		return c.send(ctx, methodName, req)
+	})
+
+	select {
+	case res := <-resultCh:
+		if res.Err != nil {
+			if ctx.Err() == nil && (errors.Is(res.Err, context.Canceled) || errors.Is(res.Err, context.DeadlineExceeded)) {
+				return c.send(ctx, methodName, req)
+			}
+			return nil, res.Err
+		}
+		return cloneResponse(res.Val.(*contracts.Response)), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// cloneResponse returns a copy of resp that shares no memory with it.
+func cloneResponse(resp *contracts.Response) *contracts.Response {
+	return &contracts.Response{
+		StatusCode: resp.StatusCode,
+		Headers:    maps.Clone(resp.Headers),
+		Body:       slices.Clone(resp.Body),
+	}
+}
@@ -0,0 +1,135 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// callConcurrently runs call from n goroutines at once and returns the errors.
+func callConcurrently(n int, call func() error) []error {
+	errs := make([]error, n)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = call()
+		}()
+	}
+	wg.Wait()
+	return errs
+}
+
+// === WithSingleFlight Tests ===
+
+func TestWithSingleFlight(t *testing.T) {
+	const goroutines = 50
+
+	tests := []struct {
+		name          string
+		options       []Option
+		method        string
+		expectedCalls func(calls int64) bool
+	}{
+		{
+			name:          "reads are shared",
+			options:       []Option{WithSingleFlight()},
+			method:        http.MethodGet,
+			expectedCalls: func(calls int64) bool { return calls < goroutines/2 },
+		},
+		{
+			name:          "reads without option",
+			method:        http.MethodGet,
+			expectedCalls: func(calls int64) bool { return calls == goroutines },
+		},
+		{
+			name:          "writes are not shared",
+			options:       []Option{WithSingleFlight()},
+			method:        http.MethodPut,
+			expectedCalls: func(calls int64) bool { return calls == goroutines },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls atomic.Int64
+			mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+				calls.Add(1)
+				time.Sleep(50 * time.Millisecond)
+				if req.Method == http.MethodPut {
+					return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+				}
+				return jsonResponse(http.StatusOK, map[string]any{"accessKeys": []*types.AccessKey{{ID: "1"}}}), nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+			ctx := context.Background()
+
+			// Act
+			errs := callConcurrently(goroutines, func() error {
+				if tt.method == http.MethodPut {
+					return client.UpdateNameAccessKey(ctx, "1", "name")
+				}
+				keys, err := client.GetAccessKeys(ctx)
+				if err == nil && (len(keys) != 1 || keys[0].ID != "1") {
+					t.Errorf("unexpected keys: %v", keys)
+				}
+				return err
+			})
+
+			// Assert
+			for _, err := range errs {
+				require.NoError(t, err)
+			}
+			assert.True(t, tt.expectedCalls(calls.Load()), "Do called %d times for %d goroutines", calls.Load(), goroutines)
+		})
+	}
+}
+
+func TestWithSingleFlight_LeaderCancelled(t *testing.T) {
+	// Arrange
+	started := make(chan struct{})
+	var calls atomic.Int64
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+			time.Sleep(50 * time.Millisecond)
+			return nil, context.Canceled
+		}
+		return jsonResponse(http.StatusOK, types.ServerInfoResponse{Name: "server"}), nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithSingleFlight())
+	leaderCtx, cancel := context.WithCancel(context.Background())
+
+	// Act
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.GetServerInfo(leaderCtx)
+		leaderErr <- err
+	}()
+	<-started
+	followerDone := make(chan struct{})
+	var info *types.ServerInfoResponse
+	var err error
+	go func() {
+		defer close(followerDone)
+		info, err = client.GetServerInfo(context.Background())
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	<-followerDone
+
+	// Assert
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	require.NoError(t, err)
+	assert.Equal(t, "server", info.Name)
+}
@@ -0,0 +1,125 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// === Backup and Restore ===
+
+// ExportState captures the server configuration and all access keys, including their
+// passwords and data limits, so that [Client.ImportState] can restore them later.
+// The server information is always read from the server, bypassing the cache of [Client.GetServerInfo].
+//
+// It returns the errors of [Client.GetServerInfo] and [Client.GetAccessKeys].
+func (c *Client) ExportState(ctx context.Context) (*types.ServerState, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	info, err := c.fetchServerInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ServerState{
+		Name:                  info.Name,
+		HostnameForAccessKeys: info.HostnameForAccessKeys,
+		PortForNewAccessKeys:  info.PortForNewAccessKeys,
+		MetricsEnabled:        info.MetricsEnabled,
+		AccessKeyDataLimit:    info.AccessKeyDataLimit,
+		AccessKeys:            keys,
+	}, nil
+}
+
+// ImportState restores a state captured by [Client.ExportState]: it applies the server configuration
+// unless [types.ImportOptions.SkipServerConfig] is set, then creates every access key of the state.
+// Existing keys are left untouched.
+//
+// Restored are the server name, hostname, default port, metrics setting and server-wide data limit,
+// and for every key its name, password, port, encryption method and data limit.
+// Keys get new IDs assigned by the server unless [types.ImportOptions.KeepIDs] is set.
+// Not restored are the server ID, creation time and version, transfer metrics, and the access URLs,
+// which the server derives from the restored hostname and key configuration.
+//
+// Importing stops at the first failed call, leaving the changes applied before it in place.
+// It returns [*ArgumentError] wrapping [InvalidArgumentError] if state is nil or holds an invalid key,
+// and the errors of the underlying calls, such as [*ClientError] or [*DoError].
+func (c *Client) ImportState(ctx context.Context, state *types.ServerState, opts types.ImportOptions) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	if state == nil {
+		return errInvalidArgument("state", errors.New("state is nil"))
+	}
+	for _, key := range state.AccessKeys {
+		if key == nil {
+			return errInvalidArgument("state", errors.New("state contains a nil access key"))
+		}
+		if key.Port < 0 || key.Port > math.MaxUint16 {
+			return errInvalidArgument("state", fmt.Errorf("access key %s: %w", key.ID, InvalidPortError))
+		}
+	}
+
+	if !opts.SkipServerConfig {
+		if err := c.importServerConfig(ctx, state); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range state.AccessKeys {
+		create := &types.CreateAccessKey{
+			Method:   key.Method,
+			Name:     key.Name,
+			Password: key.Password,
+			Port:     uint16(key.Port),
+			Limit:    key.Limit,
+		}
+		var err error
+		if opts.KeepIDs {
+			_, err = c.CreateAccessKeyWithID(ctx, key.ID, create)
+		} else {
+			_, err = c.CreateAccessKey(ctx, create)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importServerConfig applies the server configuration of state.
+// The hostname comes first, as the access URLs of the keys created afterwards depend on it.
+func (c *Client) importServerConfig(ctx context.Context, state *types.ServerState) error {
+	if state.HostnameForAccessKeys != "" {
+		if err := c.UpdateServerHostname(ctx, state.HostnameForAccessKeys); err != nil {
+			return err
+		}
+	}
+	if state.Name != "" {
+		if err := c.UpdateServerName(ctx, state.Name); err != nil {
+			return err
+		}
+	}
+	if state.PortForNewAccessKeys > 0 && state.PortForNewAccessKeys <= math.MaxUint16 {
+		if err := c.UpdatePortNewAccessKeys(ctx, uint16(state.PortForNewAccessKeys)); err != nil {
+			return err
+		}
+	}
+	if err := c.UpdateMetricsEnabled(ctx, state.MetricsEnabled); err != nil {
+		return err
+	}
+	if state.AccessKeyDataLimit != nil {
+		return c.UpdateKeyLimitBytes(ctx, state.AccessKeyDataLimit.Bytes)
+	}
+	return c.DeleteKeyLimitBytes(ctx)
+}
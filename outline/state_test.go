@@ -0,0 +1,231 @@
+package outline
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeServer is an in-memory Outline server serving the server configuration and access key endpoints.
+type fakeServer struct {
+	mu     sync.Mutex
+	info   types.ServerInfoResponse
+	keys   []*types.AccessKey
+	nextID int
+}
+
+// handle serves req against the in-memory state.
+func (s *fakeServer) handle(req *contracts.Request) (*contracts.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := requestPath(req)
+	switch req.Method + " " + path {
+	case "GET /server":
+		return jsonResponse(http.StatusOK, s.info), nil
+	case "GET /access-keys":
+		return jsonResponse(http.StatusOK, map[string]any{"accessKeys": s.keys}), nil
+	case "PUT /server/hostname-for-access-keys":
+		var body struct{ Hostname string }
+		_ = json.Unmarshal(req.Body, &body)
+		s.info.HostnameForAccessKeys = body.Hostname
+	case "PUT /name":
+		var body struct{ Name string }
+		_ = json.Unmarshal(req.Body, &body)
+		s.info.Name = body.Name
+	case "PUT /server/port-for-new-access-keys":
+		var body struct{ Port int }
+		_ = json.Unmarshal(req.Body, &body)
+		s.info.PortForNewAccessKeys = body.Port
+	case "PUT /metrics/enabled":
+		var body types.MetricsEnabled
+		_ = json.Unmarshal(req.Body, &body)
+		s.info.MetricsEnabled = body.Enabled
+	case "PUT /server/access-key-data-limit":
+		var body struct{ Limit types.Limit }
+		_ = json.Unmarshal(req.Body, &body)
+		s.info.AccessKeyDataLimit = &body.Limit
+	case "DELETE /server/access-key-data-limit":
+		s.info.AccessKeyDataLimit = nil
+	case "POST /access-keys":
+		s.nextID++
+		return s.createKey(strconv.Itoa(s.nextID), req.Body), nil
+	default:
+		if id, ok := strings.CutPrefix(path, "/access-keys/"); ok && req.Method == http.MethodPut {
+			return s.createKey(id, req.Body), nil
+		}
+		if id, ok := strings.CutPrefix(path, "/access-keys/"); ok && req.Method == http.MethodDelete {
+			return s.deleteKey(id), nil
+		}
+		return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+	}
+	return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+}
+
+// deleteKey removes the key with the given ID.
+func (s *fakeServer) deleteKey(id string) *contracts.Response {
+	for i, key := range s.keys {
+		if key.ID == id {
+			s.keys = append(s.keys[:i], s.keys[i+1:]...)
+			return &contracts.Response{StatusCode: http.StatusNoContent}
+		}
+	}
+	return &contracts.Response{StatusCode: http.StatusNotFound}
+}
+
+// createKey stores a key with the given ID built from a CreateAccessKey body.
+func (s *fakeServer) createKey(id string, body []byte) *contracts.Response {
+	for _, key := range s.keys {
+		if key.ID == id {
+			return &contracts.Response{StatusCode: http.StatusConflict}
+		}
+	}
+	var create types.CreateAccessKey
+	_ = json.Unmarshal(body, &create)
+	key := &types.AccessKey{
+		ID:        id,
+		Name:      create.Name,
+		Password:  create.Password,
+		Port:      int(create.Port),
+		Method:    create.Method,
+		AccessURL: "ss://" + create.Password + "@" + s.info.HostnameForAccessKeys + ":" + strconv.Itoa(int(create.Port)),
+		Limit:     create.Limit,
+	}
+	s.keys = append(s.keys, key)
+	return jsonResponse(http.StatusCreated, key)
+}
+
+// === ExportState / ImportState Tests ===
+
+func TestExportImportState(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        types.ImportOptions
+		expectedIDs []string
+	}{
+		{name: "server assigned ids", opts: types.ImportOptions{}, expectedIDs: []string{"1", "2"}},
+		{name: "keep ids", opts: types.ImportOptions{KeepIDs: true}, expectedIDs: []string{"7", "42"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			source := &fakeServer{
+				info: types.ServerInfoResponse{
+					Name:                  "Backup Source",
+					ServerID:              "source-id",
+					MetricsEnabled:        true,
+					Version:               "1.12.0",
+					PortForNewAccessKeys:  8388,
+					HostnameForAccessKeys: "vpn.example.com",
+					AccessKeyDataLimit:    &types.Limit{Bytes: 5000},
+				},
+				keys: []*types.AccessKey{
+					{ID: "7", Name: "Alice", Password: "alice-pass", Port: 8388, Method: "chacha20-ietf-poly1305",
+						AccessURL: "ss://alice", Limit: &types.Limit{Bytes: 1000}},
+					{ID: "42", Name: "Bob", Password: "bob-pass", Port: 9000, Method: "aes-128-gcm", AccessURL: "ss://bob"},
+				},
+			}
+			target := &fakeServer{info: types.ServerInfoResponse{ServerID: "target-id", Version: "1.13.0"}}
+			sourceClient := createTestClientForAccessKeys(newRoutingMockDoer(t, nil, source.handle))
+			targetClient := createTestClientForAccessKeys(newRoutingMockDoer(t, nil, target.handle))
+			ctx := context.Background()
+
+			// Act
+			state, exportErr := sourceClient.ExportState(ctx)
+			importErr := targetClient.ImportState(ctx, state, tt.opts)
+
+			// Assert
+			require.NoError(t, exportErr)
+			require.NoError(t, importErr)
+			assert.Equal(t, types.ServerInfoResponse{
+				Name:                  "Backup Source",
+				ServerID:              "target-id",
+				MetricsEnabled:        true,
+				Version:               "1.13.0",
+				PortForNewAccessKeys:  8388,
+				HostnameForAccessKeys: "vpn.example.com",
+				AccessKeyDataLimit:    &types.Limit{Bytes: 5000},
+			}, target.info)
+			require.Len(t, target.keys, len(source.keys))
+			for i, key := range target.keys {
+				want := source.keys[i]
+				assert.Equal(t, tt.expectedIDs[i], key.ID)
+				assert.Equal(t, want.Name, key.Name)
+				assert.Equal(t, want.Password, key.Password)
+				assert.Equal(t, want.Port, key.Port)
+				assert.Equal(t, want.Method, key.Method)
+				assert.Equal(t, want.Limit, key.Limit)
+			}
+		})
+	}
+}
+
+func TestImportState_SkipServerConfig(t *testing.T) {
+	// Arrange
+	var calls []string
+	target := &fakeServer{}
+	client := createTestClientForAccessKeys(newRoutingMockDoer(t, &calls, target.handle))
+	state := &types.ServerState{
+		Name:       "Ignored",
+		AccessKeys: []*types.AccessKey{{ID: "1", Name: "Alice", Method: "aes-128-gcm"}},
+	}
+
+	// Act
+	err := client.ImportState(context.Background(), state, types.ImportOptions{SkipServerConfig: true})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, []string{"POST /access-keys"}, calls)
+	assert.Empty(t, target.info.Name)
+}
+
+func TestImportState_KeepIDsConflict(t *testing.T) {
+	// Arrange
+	target := &fakeServer{keys: []*types.AccessKey{{ID: "1"}}}
+	client := createTestClientForAccessKeys(newRoutingMockDoer(t, nil, target.handle))
+	state := &types.ServerState{AccessKeys: []*types.AccessKey{{ID: "1", Name: "Alice"}}}
+
+	// Act
+	err := client.ImportState(context.Background(), state, types.ImportOptions{KeepIDs: true, SkipServerConfig: true})
+
+	// Assert
+	assert.ErrorIs(t, err, KeyAlreadyExistsError)
+}
+
+func TestImportState_InvalidState(t *testing.T) {
+	tests := []struct {
+		name  string
+		state *types.ServerState
+	}{
+		{name: "nil state", state: nil},
+		{name: "nil key", state: &types.ServerState{AccessKeys: []*types.AccessKey{nil}}},
+		{name: "port out of range", state: &types.ServerState{AccessKeys: []*types.AccessKey{{ID: "1", Port: 70000}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			client := createTestClientForAccessKeys(newRoutingMockDoer(t, &calls, (&fakeServer{}).handle))
+
+			// Act
+			err := client.ImportState(context.Background(), tt.state, types.ImportOptions{})
+
+			// Assert
+			var argErr *ArgumentError
+			require.ErrorAs(t, err, &argErr)
+			assert.ErrorIs(t, err, InvalidArgumentError)
+			assert.Empty(t, calls)
+		})
+	}
+}
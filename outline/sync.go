@@ -0,0 +1,121 @@
+package outline
+
+import (
+	"context"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// === Reconciliation of Access Keys ===
+
+// SyncAccessKeys reconciles the access keys on the server with the desired set.
+// Desired keys are matched against existing keys by Name: missing keys are created,
+// keys whose configuration differs (see [types.DesiredKey.ConfigEquals]) are updated,
+// and keys that are not desired are deleted only when [WithSyncDeleteExtras] is set.
+// A port or method change recreates the key with the same ID, like [Client.UpdateAccessKeyPort].
+//
+// Reconciliation stops at the first failed operation; the returned [types.SyncResult]
+// then describes the changes applied before the failure.
+//
+// It returns the errors of the underlying calls, such as [*ClientError],
+// [*UnmarshalError] or [*DoError].
+func (c *Client) SyncAccessKeys(ctx context.Context, desired []types.DesiredKey) (types.SyncResult, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	var result types.SyncResult
+
+	actual, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	byName := make(map[string]*types.AccessKey, len(actual))
+	for _, key := range actual {
+		if _, ok := byName[key.Name]; !ok {
+			byName[key.Name] = key
+		}
+	}
+
+	matched := make(map[string]struct{}, len(desired))
+	for _, d := range desired {
+		key, ok := byName[d.Name]
+		if !ok {
+			created, err := c.CreateAccessKey(ctx, &types.CreateAccessKey{
+				Method:   d.Method,
+				Name:     d.Name,
+				Password: d.Password,
+				Port:     d.Port,
+				Limit:    d.Limit,
+			})
+			if err != nil {
+				return result, err
+			}
+			byName[d.Name] = created
+			matched[created.ID] = struct{}{}
+			result.Created = append(result.Created, created.ID)
+			continue
+		}
+
+		matched[key.ID] = struct{}{}
+		if d.ConfigEquals(key) {
+			result.Unchanged = append(result.Unchanged, key.ID)
+			continue
+		}
+		if err := c.syncAccessKey(ctx, key, d); err != nil {
+			return result, err
+		}
+		result.Updated = append(result.Updated, key.ID)
+	}
+
+	if !c.syncDeleteExtras {
+		return result, nil
+	}
+
+	for _, key := range actual {
+		if _, ok := matched[key.ID]; ok {
+			continue
+		}
+		if err := c.DeleteAccessKey(ctx, key.ID); err != nil {
+			return result, err
+		}
+		result.Deleted = append(result.Deleted, key.ID)
+	}
+
+	return result, nil
+}
+
+// syncAccessKey applies the differences between the existing key and the desired configuration.
+// Outline answers PUT /access-keys/{id} with 409 for an existing key, so the name is changed through
+// the name endpoint, the port and method by recreating the key (see [Client.UpdateAccessKeyPort]),
+// and the data limit through the data-limit endpoints.
+func (c *Client) syncAccessKey(ctx context.Context, key *types.AccessKey, d types.DesiredKey) error {
+	portChanged := d.Port != 0 && key.Port != int(d.Port)
+	methodChanged := d.Method != "" && key.Method != d.Method
+	if portChanged || methodChanged {
+		if _, err := c.recreateAccessKey(ctx, key.ID, func(k *types.CreateAccessKey) {
+			k.Name = d.Name
+			if portChanged {
+				k.Port = d.Port
+			}
+			if methodChanged {
+				k.Method = d.Method
+			}
+		}); err != nil {
+			return err
+		}
+	} else if key.Name != d.Name {
+		if err := c.UpdateNameAccessKey(ctx, key.ID, d.Name); err != nil {
+			return err
+		}
+	}
+
+	onlyLimit := types.DesiredKey{Name: key.Name, Limit: d.Limit}
+	if onlyLimit.ConfigEquals(key) {
+		return nil
+	}
+	if d.Limit == nil || d.Limit.Bytes == 0 {
+		return c.DeleteDataLimitAccessKey(ctx, key.ID)
+	}
+	return c.UpdateDataLimitAccessKey(ctx, key.ID, d.Limit.Bytes)
+}
@@ -0,0 +1,297 @@
+package outline
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newRoutingMockDoer configures generated mock to answer every request with the handler
+// and records "METHOD /path" for each request in calls.
+func newRoutingMockDoer(
+	t *testing.T,
+	calls *[]string,
+	handler func(req *contracts.Request) (*contracts.Response, error),
+) *MockDoer {
+	var mu sync.Mutex
+	m := NewMockDoer(t)
+	m.On("Do", mock.Anything, mock.AnythingOfType("*contracts.Request")).
+		Return(func(_ context.Context, req *contracts.Request) (*contracts.Response, error) {
+			if calls != nil {
+				mu.Lock()
+				*calls = append(*calls, req.Method+" "+requestPath(req))
+				mu.Unlock()
+			}
+			return handler(req)
+		}).
+		Maybe()
+	return m
+}
+
+// requestPath returns the request path relative to the test base URL.
+func requestPath(req *contracts.Request) string {
+	u, _ := url.Parse(req.URL)
+	return strings.TrimPrefix(u.Path, "/api")
+}
+
+// jsonResponse builds a response with the given status and marshaled body.
+func jsonResponse(statusCode int, body any) *contracts.Response {
+	data, _ := json.Marshal(body)
+	return &contracts.Response{StatusCode: statusCode, Body: data}
+}
+
+// === SyncAccessKeys Tests ===
+
+func syncTestKeys() []*types.AccessKey {
+	return []*types.AccessKey{
+		{ID: "1", Name: "alice", Password: "p1", Port: 8080, Method: types.MethodAES128GCM},
+		{ID: "2", Name: "bob", Password: "p2", Port: 8080, Method: types.MethodAES128GCM, Limit: &types.Limit{Bytes: 100}},
+		{ID: "3", Name: "carol", Password: "p3", Port: 8080, Method: types.MethodAES128GCM},
+	}
+}
+
+// syncTestHandler serves keys like Outline does: PUT /access-keys/{id} answers 409
+// unless the key was deleted before.
+func syncTestHandler(t *testing.T, keys []*types.AccessKey) func(req *contracts.Request) (*contracts.Response, error) {
+	var mu sync.Mutex
+	deleted := make(map[string]bool)
+	return func(req *contracts.Request) (*contracts.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		path := requestPath(req)
+		id := strings.TrimPrefix(path, "/access-keys/")
+		switch {
+		case req.Method == http.MethodGet && path == "/access-keys":
+			return jsonResponse(http.StatusOK, map[string]any{"accessKeys": keys}), nil
+		case req.Method == http.MethodPost && path == "/access-keys":
+			var create types.CreateAccessKey
+			require.NoError(t, json.Unmarshal(req.Body, &create))
+			return jsonResponse(http.StatusCreated, types.AccessKey{
+				ID: "new-" + create.Name, Name: create.Name, Method: create.Method, Port: int(create.Port),
+			}), nil
+		case strings.HasSuffix(path, "/data-limit"), strings.HasSuffix(path, "/name"):
+			return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+		case req.Method == http.MethodGet:
+			for _, key := range keys {
+				if key.ID == id && !deleted[id] {
+					return jsonResponse(http.StatusOK, key), nil
+				}
+			}
+			return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+		case req.Method == http.MethodDelete:
+			deleted[id] = true
+			return &contracts.Response{StatusCode: http.StatusNoContent}, nil
+		case req.Method == http.MethodPut:
+			if !deleted[id] {
+				return &contracts.Response{StatusCode: http.StatusConflict}, nil
+			}
+			var create types.CreateAccessKey
+			require.NoError(t, json.Unmarshal(req.Body, &create))
+			delete(deleted, id)
+			return jsonResponse(http.StatusCreated, types.AccessKey{
+				ID: id, Name: create.Name, Method: create.Method, Port: int(create.Port),
+			}), nil
+		}
+		return &contracts.Response{StatusCode: http.StatusTeapot}, nil
+	}
+}
+
+func TestSyncAccessKeys_Transitions(t *testing.T) {
+	tests := []struct {
+		name          string
+		desired       []types.DesiredKey
+		expected      types.SyncResult
+		expectedCalls []string
+	}{
+		{
+			name: "create missing key",
+			desired: []types.DesiredKey{
+				{Name: "alice"},
+				{Name: "bob", Limit: &types.Limit{Bytes: 100}},
+				{Name: "carol"},
+				{Name: "dave", Method: types.MethodAES256GCM, Port: 9000},
+			},
+			expected: types.SyncResult{
+				Created:   []string{"new-dave"},
+				Unchanged: []string{"1", "2", "3"},
+			},
+			expectedCalls: []string{"GET /access-keys", "POST /access-keys"},
+		},
+		{
+			name: "update port and method",
+			desired: []types.DesiredKey{
+				{Name: "alice", Method: types.MethodAES256GCM, Port: 9000},
+			},
+			expected: types.SyncResult{
+				Updated: []string{"1"},
+			},
+			expectedCalls: []string{
+				"GET /access-keys", "GET /access-keys/1", "DELETE /access-keys/1", "PUT /access-keys/1",
+			},
+		},
+		{
+			name: "set data limit",
+			desired: []types.DesiredKey{
+				{Name: "alice", Limit: &types.Limit{Bytes: 500}},
+			},
+			expected: types.SyncResult{
+				Updated: []string{"1"},
+			},
+			expectedCalls: []string{"GET /access-keys", "PUT /access-keys/1/data-limit"},
+		},
+		{
+			name: "remove data limit",
+			desired: []types.DesiredKey{
+				{Name: "bob"},
+			},
+			expected: types.SyncResult{
+				Updated: []string{"2"},
+			},
+			expectedCalls: []string{"GET /access-keys", "DELETE /access-keys/2/data-limit"},
+		},
+		{
+			name: "all unchanged",
+			desired: []types.DesiredKey{
+				{Name: "alice", Method: types.MethodAES128GCM, Port: 8080},
+				{Name: "bob", Limit: &types.Limit{Bytes: 100}},
+			},
+			expected: types.SyncResult{
+				Unchanged: []string{"1", "2"},
+			},
+			expectedCalls: []string{"GET /access-keys"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, syncTestHandler(t, syncTestKeys()))
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			result, err := client.SyncAccessKeys(context.Background(), tt.desired)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestSyncAccessKeys_DeleteGuard(t *testing.T) {
+	tests := []struct {
+		name          string
+		options       []Option
+		expected      types.SyncResult
+		expectedCalls []string
+	}{
+		{
+			name: "extras kept by default",
+			expected: types.SyncResult{
+				Unchanged: []string{"1"},
+			},
+			expectedCalls: []string{"GET /access-keys"},
+		},
+		{
+			name:    "extras deleted with option",
+			options: []Option{WithSyncDeleteExtras()},
+			expected: types.SyncResult{
+				Deleted:   []string{"2", "3"},
+				Unchanged: []string{"1"},
+			},
+			expectedCalls: []string{"GET /access-keys", "DELETE /access-keys/2", "DELETE /access-keys/3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, syncTestHandler(t, syncTestKeys()))
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+
+			// Act
+			result, err := client.SyncAccessKeys(context.Background(), []types.DesiredKey{{Name: "alice"}})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, tt.expectedCalls, calls)
+		})
+	}
+}
+
+func TestSyncAccessKeys_StopsOnError(t *testing.T) {
+	// Arrange
+	handler := syncTestHandler(t, syncTestKeys())
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		if req.Method == http.MethodPost {
+			return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+		}
+		return handler(req)
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.SyncAccessKeys(context.Background(), []types.DesiredKey{
+		{Name: "alice"},
+		{Name: "erin"},
+		{Name: "bob"},
+	})
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+	assert.Equal(t, types.SyncResult{Unchanged: []string{"1"}}, result)
+}
+
+func TestSyncAccessKeys_GetAccessKeysError(t *testing.T) {
+	// Arrange
+	mockDoer := newMockDoerAccessKey(t, &contracts.Response{StatusCode: http.StatusInternalServerError}, nil, nil)
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.SyncAccessKeys(context.Background(), []types.DesiredKey{{Name: "alice"}})
+
+	// Assert
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.Empty(t, result)
+}
+
+func TestDesiredKey_ConfigEquals(t *testing.T) {
+	key := &types.AccessKey{ID: "1", Name: "alice", Port: 8080, Method: types.MethodAES128GCM}
+
+	tests := []struct {
+		name     string
+		desired  types.DesiredKey
+		key      *types.AccessKey
+		expected bool
+	}{
+		{name: "name only", desired: types.DesiredKey{Name: "alice"}, key: key, expected: true},
+		{name: "different name", desired: types.DesiredKey{Name: "bob"}, key: key, expected: false},
+		{name: "different port", desired: types.DesiredKey{Name: "alice", Port: 9000}, key: key, expected: false},
+		{name: "different method", desired: types.DesiredKey{Name: "alice", Method: types.MethodAES256GCM}, key: key, expected: false},
+		{name: "zero limit equals no limit", desired: types.DesiredKey{Name: "alice", Limit: &types.Limit{}}, key: key, expected: true},
+		{name: "different limit", desired: types.DesiredKey{Name: "alice", Limit: &types.Limit{Bytes: 1}}, key: key, expected: false},
+		{name: "nil key", desired: types.DesiredKey{Name: "alice"}, key: nil, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.desired.ConfigEquals(tt.key))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// requestTimeout returns the timeout for a request with the HTTP method:
+// [WithReadTimeout] for GET and HEAD, [WithWriteTimeout] for other methods,
+// falling back to [WithTimeout] when the specific timeout is not set.
+func (c *Client) requestTimeout(method string) time.Duration {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		if c.readTimeout > 0 {
+			return c.readTimeout
+		}
+	default:
+		if c.writeTimeout > 0 {
+			return c.writeTimeout
+		}
+	}
+	return c.timeout
+}
+
+// withOperationTimeout bounds a call to a public method by the timeout set with [WithOperationTimeout]
+// if ctx has no deadline. Methods built on other public methods wrap ctx once: the inner calls
+// see the deadline and leave ctx untouched.
+func (c *Client) withOperationTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || c.operationTimeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, c.operationTimeout)
+}
+
+// withRequestTimeout derives a context bounded by the timeout for the HTTP method and,
+// if ctx has no deadline, by the fallback set with [WithContextTimeoutFallback].
+// Without a configured timeout ctx is returned unchanged.
+func (c *Client) withRequestTimeout(ctx context.Context, method string) (context.Context, context.CancelFunc) {
+	cancelFallback := func() {}
+	if _, ok := ctx.Deadline(); !ok && c.contextTimeoutFallback > 0 {
+		ctx, cancelFallback = context.WithTimeout(ctx, c.contextTimeoutFallback)
+	}
+
+	timeout := c.requestTimeout(method)
+	if timeout <= 0 {
+		return ctx, cancelFallback
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, func() {
+		cancel()
+		cancelFallback()
+	}
+}
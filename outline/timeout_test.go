@@ -0,0 +1,302 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newDeadlineMockDoer configures generated mock to answer with status and record
+// the time left until the context deadline, or zero if the context has no deadline.
+func newDeadlineMockDoer(t *testing.T, status int, remaining *time.Duration) *MockDoer {
+	m := NewMockDoer(t)
+	m.On("Do", mock.Anything, mock.AnythingOfType("*contracts.Request")).
+		Return(func(ctx context.Context, _ *contracts.Request) (*contracts.Response, error) {
+			if deadline, ok := ctx.Deadline(); ok {
+				*remaining = time.Until(deadline)
+			}
+			return &contracts.Response{StatusCode: status, Body: []byte(`{"accessKeys":[]}`)}, nil
+		})
+	return m
+}
+
+// === WithReadTimeout / WithWriteTimeout Tests ===
+
+func TestRequestTimeouts(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []Option
+		call     func(ctx context.Context, c *Client) error
+		status   int
+		expected time.Duration
+	}{
+		{
+			name:    "GET uses read timeout",
+			options: []Option{WithTimeout(time.Hour), WithReadTimeout(time.Minute), WithWriteTimeout(10 * time.Minute)},
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetAccessKeys(ctx)
+				return err
+			},
+			status:   http.StatusOK,
+			expected: time.Minute,
+		},
+		{
+			name:    "DELETE uses write timeout",
+			options: []Option{WithTimeout(time.Hour), WithReadTimeout(time.Minute), WithWriteTimeout(10 * time.Minute)},
+			call: func(ctx context.Context, c *Client) error {
+				return c.DeleteAccessKey(ctx, "1")
+			},
+			status:   http.StatusNoContent,
+			expected: 10 * time.Minute,
+		},
+		{
+			name:    "GET falls back to timeout",
+			options: []Option{WithTimeout(time.Hour), WithWriteTimeout(10 * time.Minute)},
+			call: func(ctx context.Context, c *Client) error {
+				_, err := c.GetAccessKeys(ctx)
+				return err
+			},
+			status:   http.StatusOK,
+			expected: time.Hour,
+		},
+		{
+			name:    "DELETE falls back to timeout",
+			options: []Option{WithTimeout(time.Hour), WithReadTimeout(time.Minute)},
+			call: func(ctx context.Context, c *Client) error {
+				return c.DeleteAccessKey(ctx, "1")
+			},
+			status:   http.StatusNoContent,
+			expected: time.Hour,
+		},
+		{
+			name:    "no timeout",
+			options: nil,
+			call: func(ctx context.Context, c *Client) error {
+				return c.DeleteAccessKey(ctx, "1")
+			},
+			status:   http.StatusNoContent,
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var remaining time.Duration
+			mockDoer := newDeadlineMockDoer(t, tt.status, &remaining)
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+
+			// Act
+			err := tt.call(context.Background(), client)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, remaining, float64(time.Second))
+		})
+	}
+}
+
+func TestRequestTimeouts_ShorterParentDeadlineWins(t *testing.T) {
+	// Arrange
+	var remaining time.Duration
+	mockDoer := newDeadlineMockDoer(t, http.StatusOK, &remaining)
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithReadTimeout(time.Hour))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// Act
+	_, err := client.GetAccessKeys(ctx)
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, time.Minute, remaining, float64(time.Second))
+}
+
+func TestRequestTimeouts_NegativeRejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		option Option
+	}{
+		{name: "WithTimeout", option: WithTimeout(-time.Second)},
+		{name: "WithReadTimeout", option: WithReadTimeout(-time.Second)},
+		{name: "WithWriteTimeout", option: WithWriteTimeout(-time.Second)},
+		{name: "WithContextTimeoutFallback", option: WithContextTimeoutFallback(-time.Second)},
+		{name: "WithOperationTimeout", option: WithOperationTimeout(-time.Second)},
+		{name: "WithPortCheckTimeout", option: WithPortCheckTimeout(-time.Second)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			client, err := NewClient("http://localhost:8081/api/", "", tt.option)
+
+			// Assert
+			assert.Nil(t, client)
+			assert.ErrorIs(t, err, InvalidOptionError)
+			assert.Contains(t, err.Error(), "option: "+tt.name)
+		})
+	}
+}
+
+// === WithContextTimeoutFallback Tests ===
+
+func TestContextTimeoutFallback(t *testing.T) {
+	tests := []struct {
+		name       string
+		options    []Option
+		ctxTimeout time.Duration
+		expected   time.Duration
+	}{
+		{
+			name:     "context without deadline gets fallback",
+			options:  []Option{WithContextTimeoutFallback(time.Minute)},
+			expected: time.Minute,
+		},
+		{
+			name:       "shorter context deadline kept",
+			options:    []Option{WithContextTimeoutFallback(time.Hour)},
+			ctxTimeout: time.Minute,
+			expected:   time.Minute,
+		},
+		{
+			name:       "longer context deadline kept",
+			options:    []Option{WithContextTimeoutFallback(time.Minute)},
+			ctxTimeout: time.Hour,
+			expected:   time.Hour,
+		},
+		{
+			name:     "shorter read timeout wins",
+			options:  []Option{WithContextTimeoutFallback(time.Hour), WithReadTimeout(time.Minute)},
+			expected: time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var remaining time.Duration
+			mockDoer := newDeadlineMockDoer(t, http.StatusOK, &remaining)
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			// Act
+			_, err := client.GetAccessKeys(ctx)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, remaining, float64(time.Second))
+		})
+	}
+}
+
+func TestContextTimeoutFallback_SlowDoer(t *testing.T) {
+	// Arrange
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(newHangingMockDoer(t)),
+		WithContextTimeoutFallback(50*time.Millisecond))
+	start := time.Now()
+
+	// Act
+	_, err := client.GetServerInfo(context.Background())
+
+	// Assert
+	var doErr *DoError
+	require.ErrorAs(t, err, &doErr)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+// === WithOperationTimeout Tests ===
+
+// newHangingMockDoer configures generated mock to return only once the context of the request is done.
+func newHangingMockDoer(t *testing.T) *MockDoer {
+	m := NewMockDoer(t)
+	m.On("Do", mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, _ *contracts.Request) (*contracts.Response, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).
+		Maybe()
+	return m
+}
+
+func TestOperationTimeout_SlowDoer(t *testing.T) {
+	// Arrange
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(newHangingMockDoer(t)),
+		WithOperationTimeout(50*time.Millisecond))
+	start := time.Now()
+
+	// Act
+	_, err := client.GetServerInfo(context.Background())
+
+	// Assert
+	var doErr *DoError
+	require.ErrorAs(t, err, &doErr)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestOperationTimeout_Deadline(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctxTimeout time.Duration
+		expected   time.Duration
+	}{
+		{name: "context without deadline gets timeout", expected: time.Minute},
+		{name: "longer context deadline kept", ctxTimeout: time.Hour, expected: time.Hour},
+		{name: "shorter context deadline kept", ctxTimeout: time.Second, expected: time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var remaining time.Duration
+			mockDoer := newDeadlineMockDoer(t, http.StatusOK, &remaining)
+			client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer),
+				WithOperationTimeout(time.Minute))
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+
+			// Act
+			_, err := client.GetAccessKeys(ctx)
+
+			// Assert
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, remaining, float64(time.Second))
+		})
+	}
+}
+
+func TestOperationTimeout_BoundsWholeCall(t *testing.T) {
+	// Arrange
+	// Every attempt fails at once with a transient error, so only the operation timeout ends WaitReady.
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusServiceUnavailable}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer),
+		WithContextTimeoutFallback(time.Hour), WithOperationTimeout(50*time.Millisecond))
+	start := time.Now()
+
+	// Act
+	err := client.WaitReady(context.Background(), 10*time.Millisecond)
+
+	// Assert
+	var notReadyErr *NotReadyError
+	require.ErrorAs(t, err, &notReadyErr)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,102 @@
+// Package transport provides implementations of the Doer interface of package outline
+// other than the default fasthttp-based transport.
+package transport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+)
+
+var _ contracts.Doer = (*NetHTTPDoer)(nil)
+
+// NetHTTPDoer is a [contracts.Doer] sending requests through a standard library [http.Client],
+// for callers who need its behavior, e.g. proxies from [http.ProxyFromEnvironment] or their own
+// [http.Transport]. Pass it to the client with outline.WithClient.
+type NetHTTPDoer struct {
+	client *http.Client
+}
+
+// NewNetHTTPDoer returns a [NetHTTPDoer] using client, or [http.DefaultClient] if client is nil.
+func NewNetHTTPDoer(client *http.Client) *NetHTTPDoer {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &NetHTTPDoer{client: client}
+}
+
+// Do sends the request with the context and returns the response with its body read and closed.
+// A Host header sets the host of the request. The response headers are returned in canonical form;
+// a header with several values is joined into one, separated by commas.
+// A [*url.Error] is returned with only the scheme and host of the URL, as the path of
+// the management API URL carries its secret.
+func (d *NetHTTPDoer) Do(ctx context.Context, req *contracts.Request) (*contracts.Response, error) {
+	var body io.Reader
+	if req.Body != nil {
+		body = bytes.NewReader(req.Body)
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method, req.URL, body)
+	if err != nil {
+		return nil, redactURLError(err)
+	}
+	for key, value := range req.Headers {
+		if http.CanonicalHeaderKey(key) == "Host" {
+			httpReq.Host = value
+			continue
+		}
+		httpReq.Header.Set(key, value)
+	}
+
+	httpResp, err := d.client.Do(httpReq)
+	if err != nil {
+		return nil, redactURLError(err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string, len(httpResp.Header))
+	for key, values := range httpResp.Header {
+		headers[key] = strings.Join(values, ", ")
+	}
+
+	return &contracts.Response{
+		StatusCode: httpResp.StatusCode,
+		Headers:    headers,
+		Body:       respBody,
+	}, nil
+}
+
+// Close closes the idle keep-alive connections of the underlying [http.Client].
+// Requests sent afterwards open new connections.
+func (d *NetHTTPDoer) Close() error {
+	d.client.CloseIdleConnections()
+	return nil
+}
+
+// redactURLError strips the URL of a [*url.Error] down to its scheme and host.
+func redactURLError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	return &url.Error{Op: urlErr.Op, URL: redactURL(urlErr.URL), Err: urlErr.Err}
+}
+
+// redactURL returns the scheme and host of raw, or an empty string if raw cannot be parsed.
+func redactURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return ""
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host}).String()
+}
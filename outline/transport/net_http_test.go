@@ -0,0 +1,176 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEchoServer answers every request with status 201, echoing the method, path, Host,
+// X-Request header and body in response headers and the body.
+func newEchoServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Method", r.Method)
+		w.Header().Set("X-Path", r.URL.Path)
+		w.Header().Set("X-Host", r.Host)
+		w.Header().Set("X-Request", r.Header.Get("X-Request"))
+		w.Header().Add("X-Multi", "a")
+		w.Header().Add("X-Multi", "b")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestNetHTTPDoer_Do(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		body   []byte
+	}{
+		{name: "POST with body", method: http.MethodPost, body: []byte(`{"name":"alice"}`)},
+		{name: "PUT with body", method: http.MethodPut, body: []byte(`{"port":8388}`)},
+		{name: "GET without body", method: http.MethodGet},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			srv := newEchoServer(t)
+			doer := NewNetHTTPDoer(srv.Client())
+
+			// Act
+			resp, err := doer.Do(context.Background(), &contracts.Request{
+				Method:  tt.method,
+				URL:     srv.URL + "/api/access-keys",
+				Headers: map[string]string{"x-request": "42", "Content-Type": "application/json"},
+				Body:    tt.body,
+			})
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusCreated, resp.StatusCode)
+			assert.Equal(t, tt.method, resp.Headers["X-Method"])
+			assert.Equal(t, "/api/access-keys", resp.Headers["X-Path"])
+			assert.Equal(t, "42", resp.Headers["X-Request"])
+			assert.Equal(t, "a, b", resp.Headers["X-Multi"])
+			assert.Equal(t, string(tt.body), string(resp.Body))
+		})
+	}
+}
+
+func TestNetHTTPDoer_Do_HostHeader(t *testing.T) {
+	// Arrange
+	srv := newEchoServer(t)
+	doer := NewNetHTTPDoer(nil)
+
+	// Act
+	resp, err := doer.Do(context.Background(), &contracts.Request{
+		Method:  http.MethodGet,
+		URL:     srv.URL,
+		Headers: map[string]string{"Host": "outline.example.com"},
+	})
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "outline.example.com", resp.Headers["X-Host"])
+}
+
+func TestNetHTTPDoer_Do_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         func() context.Context
+		url         string
+		expectedErr error
+	}{
+		{
+			name: "cancelled context",
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			expectedErr: context.Canceled,
+		},
+		{
+			name: "invalid URL",
+			ctx:  context.Background,
+			url:  "://invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			srv := newEchoServer(t)
+			doer := NewNetHTTPDoer(srv.Client())
+			url := srv.URL
+			if tt.url != "" {
+				url = tt.url
+			}
+
+			// Act
+			resp, err := doer.Do(tt.ctx(), &contracts.Request{Method: http.MethodGet, URL: url})
+
+			// Assert
+			assert.Nil(t, resp)
+			require.Error(t, err)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestNetHTTPDoer_Do_ErrorHidesSecret(t *testing.T) {
+	const secret = "TOPSECRET123"
+
+	tests := []struct {
+		name string
+		url  string
+	}{
+		{name: "connection refused", url: "http://127.0.0.1:1/" + secret + "/server"},
+		{name: "invalid URL", url: "http://127.0.0.1:1/" + secret + "/server\x7f"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			doer := NewNetHTTPDoer(nil)
+
+			// Act
+			_, err := doer.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: tt.url})
+
+			// Assert
+			require.Error(t, err)
+			assert.NotContains(t, err.Error(), secret)
+		})
+	}
+}
+
+func TestNetHTTPDoer_Close(t *testing.T) {
+	// Arrange
+	srv := newEchoServer(t)
+	doer := NewNetHTTPDoer(&http.Client{Timeout: time.Second})
+	_, err := doer.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+	require.NoError(t, err)
+
+	// Act
+	closeErr := doer.Close()
+	resp, err := doer.Do(context.Background(), &contracts.Request{Method: http.MethodGet, URL: srv.URL})
+
+	// Assert
+	assert.NoError(t, closeErr)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+}
@@ -2,21 +2,74 @@
 // server information, metrics, and related API requests and responses.
 package types
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
 // AccessKey represents an access key for VPN connection.
 type AccessKey struct {
-	ID        string `json:"id"`        // ID is the unique identifier of the access key.
-	Name      string `json:"name"`      // Name is the human-readable name of the access key.
-	Password  string `json:"password"`  // Password is the password used for client connection.
-	Port      int    `json:"port"`      // Port is the TCP/UDP port on which the access key is available.
-	Method    string `json:"method"`    // Method is the encryption method used.
-	AccessURL string `json:"accessUrl"` // AccessURL is the URL for accessing the key.
+	ID        string `json:"id" yaml:"id"`                                   // ID is the unique identifier of the access key. See [AccessKey.UnmarshalJSON].
+	Name      string `json:"name" yaml:"name"`                               // Name is the human-readable name of the access key.
+	Password  string `json:"password" yaml:"password"`                       // Password is the password used for client connection.
+	Port      int    `json:"port" yaml:"port"`                               // Port is the TCP/UDP port on which the access key is available.
+	Method    string `json:"method" yaml:"method"`                           // Method is the encryption method used.
+	AccessURL string `json:"accessUrl" yaml:"accessUrl"`                     // AccessURL is the URL for accessing the key.
+	Limit     *Limit `json:"dataLimit,omitempty" yaml:"dataLimit,omitempty"` // Limit is the data transfer limit of the key, or nil if the key is unlimited.
+
+	// CreatedAt is the creation time of the key, or nil if the server does not report it.
+	CreatedAt *time.Time `json:"createdAt,omitempty" yaml:"createdAt,omitempty"`
+	// ModifiedAt is the time the key was last modified, or nil if the server does not report it.
+	ModifiedAt *time.Time `json:"modifiedAt,omitempty" yaml:"modifiedAt,omitempty"`
+}
+
+// UnmarshalJSON decodes the access key, accepting the ID as a JSON string or as a JSON number
+// such as 123, which some server versions send; a number is stored in its decimal form, e.g. "123".
+// A null or missing ID leaves ID empty.
+func (k *AccessKey) UnmarshalJSON(data []byte) error {
+	type accessKey AccessKey // drops the method set to avoid recursion
+	aux := struct {
+		*accessKey
+		ID json.RawMessage `json:"id"`
+	}{accessKey: (*accessKey)(k)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	id, err := parseAccessKeyID(aux.ID)
+	if err != nil {
+		return err
+	}
+	k.ID = id
+	return nil
+}
+
+// parseAccessKeyID decodes an access key ID given as a JSON string, a JSON number, or null.
+func parseAccessKeyID(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return "", nil
+	}
+
+	if raw[0] == '"' {
+		var id string
+		err := json.Unmarshal(raw, &id)
+		return id, err
+	}
+
+	var id json.Number
+	if err := json.Unmarshal(raw, &id); err != nil {
+		return "", fmt.Errorf("invalid access key ID %s: %w", raw, err)
+	}
+	return id.String(), nil
 }
 
 // CreateAccessKey represents a request to create a new access key.
 type CreateAccessKey struct {
-	Method   string `json:"method"`             // Method is the required encryption algorithm that defines the cryptographic method for protecting traffic. Example: "aes-192-gcm".
-	Name     string `json:"name,omitempty"`     // Name is the optional human-readable name for identifying this key, used for organization and management. Example: "Work Laptop". If not specified, the server may assign a default name.
-	Password string `json:"password,omitempty"` // Password is the optional password used for client connection, used together with the encryption method. Example: "8iu8V8EeoFVpwQvQeS9wiD". If not specified, the server will generate a secure password.
-	Port     uint16 `json:"port,omitempty"`     // Port is the optional TCP/UDP port on which this access key will be available. Example: 8388. If not specified, uses portForNewAccessKeys from server configuration.
-	Limit    *Limit `json:"limit,omitempty"`    // Limit is the optional data transfer limit specifying the maximum number of bytes that can be transferred through this access key. After reaching the limit, traffic may be blocked. Example: {"bytes": 10000} where bytes is the maximum number of bytes (0 means no limit).
+	Method   string `json:"method" yaml:"method"`                         // Method is the required encryption algorithm that defines the cryptographic method for protecting traffic. Example: "aes-256-gcm". See [ValidEncryptionMethods].
+	Name     string `json:"name,omitempty" yaml:"name,omitempty"`         // Name is the optional human-readable name for identifying this key, used for organization and management. Example: "Work Laptop". If not specified, the server may assign a default name.
+	Password string `json:"password,omitempty" yaml:"password,omitempty"` // Password is the optional password used for client connection, used together with the encryption method. Example: "8iu8V8EeoFVpwQvQeS9wiD". If not specified, the server will generate a secure password.
+	Port     uint16 `json:"port,omitempty" yaml:"port,omitempty"`         // Port is the optional TCP/UDP port on which this access key will be available. Example: 8388. If not specified, uses portForNewAccessKeys from server configuration.
+	Limit    *Limit `json:"limit,omitempty" yaml:"limit,omitempty"`       // Limit is the optional data transfer limit specifying the maximum number of bytes that can be transferred through this access key. After reaching the limit, traffic may be blocked. Example: {"bytes": 10000} where bytes is the maximum number of bytes (0 means no limit).
 }
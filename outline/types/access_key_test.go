@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessKey_UnmarshalID(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		expected    string
+		expectedErr bool
+	}{
+		{name: "string", id: `"id":"123",`, expected: "123"},
+		{name: "number", id: `"id":123,`, expected: "123"},
+		{name: "null", id: `"id":null,`, expected: ""},
+		{name: "absent", id: ``, expected: ""},
+		{name: "boolean", id: `"id":true,`, expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			data := `{` + tt.id + `"name":"alice","port":8388,"method":"chacha20-ietf-poly1305","dataLimit":{"bytes":1000}}`
+
+			// Act
+			var key AccessKey
+			err := json.Unmarshal([]byte(data), &key)
+
+			// Assert
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, key.ID)
+			assert.Equal(t, "alice", key.Name)
+			assert.Equal(t, 8388, key.Port)
+			assert.Equal(t, "chacha20-ietf-poly1305", key.Method)
+			require.NotNil(t, key.Limit)
+			assert.Equal(t, uint64(1000), key.Limit.Bytes)
+		})
+	}
+}
+
+func TestAccessKey_MarshalRoundTrip(t *testing.T) {
+	// Arrange
+	key := AccessKey{ID: "7", Name: "alice", Port: 8388, Limit: &Limit{Bytes: 1000}}
+
+	// Act
+	data, marshalErr := json.Marshal(key)
+	var decoded AccessKey
+	unmarshalErr := json.Unmarshal(data, &decoded)
+
+	// Assert
+	require.NoError(t, marshalErr)
+	require.NoError(t, unmarshalErr)
+	assert.Equal(t, key, decoded)
+	assert.Contains(t, string(data), `"id":"7"`)
+}
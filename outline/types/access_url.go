@@ -0,0 +1,61 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+)
+
+// InvalidAccessURLError indicates that an access key has no valid ss:// access URL.
+var InvalidAccessURLError = errors.New("invalid access URL")
+
+// QRPayload returns the ss:// access URL of the key in the canonical form used for QR codes,
+// with the key name appended as the #name fragment when the URL has no fragment yet.
+// Rendering the QR image is left to the caller.
+//
+// It returns an error wrapping [InvalidAccessURLError] if AccessURL is not a valid ss:// URL.
+func (k *AccessKey) QRPayload() (string, error) {
+	u, err := parseAccessURL(k.AccessURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Fragment == "" && k.Name != "" {
+		u.Fragment = k.Name
+	}
+	return u.String(), nil
+}
+
+// AccessURLWithHost returns the ss:// access URL of the key with its host replaced by host,
+// keeping the user info (method and password), port, query and fragment (tag).
+// An empty host returns the access URL unchanged.
+//
+// It returns an error wrapping [InvalidAccessURLError] if AccessURL is not a valid ss:// URL.
+func (k *AccessKey) AccessURLWithHost(host string) (string, error) {
+	u, err := parseAccessURL(k.AccessURL)
+	if err != nil {
+		return "", err
+	}
+	if host != "" {
+		u.Host = net.JoinHostPort(host, u.Port())
+	}
+	return u.String(), nil
+}
+
+// parseAccessURL parses raw and checks that it is an ss:// URL with a host and a port.
+func parseAccessURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", InvalidAccessURLError, err)
+	}
+	if u.Scheme != "ss" {
+		return nil, fmt.Errorf("%w: scheme %q is not ss", InvalidAccessURLError, u.Scheme)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, fmt.Errorf("%w: missing user info", InvalidAccessURLError)
+	}
+	if u.Hostname() == "" || u.Port() == "" {
+		return nil, fmt.Errorf("%w: missing host or port", InvalidAccessURLError)
+	}
+	return u, nil
+}
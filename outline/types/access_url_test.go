@@ -0,0 +1,126 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessKey_QRPayload(t *testing.T) {
+	const accessURL = "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@203.0.113.1:8388/?outline=1"
+
+	tests := []struct {
+		name     string
+		key      AccessKey
+		expected string
+	}{
+		{
+			name:     "name appended as fragment",
+			key:      AccessKey{Name: "Work Laptop", AccessURL: accessURL},
+			expected: accessURL + "#Work%20Laptop",
+		},
+		{
+			name:     "no name",
+			key:      AccessKey{AccessURL: accessURL},
+			expected: accessURL,
+		},
+		{
+			name:     "existing fragment kept",
+			key:      AccessKey{Name: "Work Laptop", AccessURL: accessURL + "#Phone"},
+			expected: accessURL + "#Phone",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			payload, err := tt.key.QRPayload()
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, payload)
+		})
+	}
+}
+
+func TestAccessKey_QRPayload_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		accessURL string
+	}{
+		{name: "empty", accessURL: ""},
+		{name: "wrong scheme", accessURL: "https://user@example.com:8388"},
+		{name: "missing user info", accessURL: "ss://example.com:8388"},
+		{name: "missing port", accessURL: "ss://user@example.com"},
+		{name: "unparsable", accessURL: "ss://user@[::1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			key := AccessKey{Name: "key", AccessURL: tt.accessURL}
+
+			// Act
+			payload, err := key.QRPayload()
+
+			// Assert
+			assert.Empty(t, payload)
+			assert.ErrorIs(t, err, InvalidAccessURLError)
+		})
+	}
+}
+
+func TestAccessKey_AccessURLWithHost(t *testing.T) {
+	tests := []struct {
+		name      string
+		accessURL string
+		host      string
+		expected  string
+	}{
+		{
+			name:      "ip replaced by hostname",
+			accessURL: "ss://Y2hhY2hhMjA6cGFzcw@10.0.0.5:8388/?outline=1#Laptop",
+			host:      "vpn.example.com",
+			expected:  "ss://Y2hhY2hhMjA6cGFzcw@vpn.example.com:8388/?outline=1#Laptop",
+		},
+		{
+			name:      "ipv6 host",
+			accessURL: "ss://Y2hhY2hhMjA6cGFzcw@10.0.0.5:8388",
+			host:      "2001:db8::1",
+			expected:  "ss://Y2hhY2hhMjA6cGFzcw@[2001:db8::1]:8388",
+		},
+		{
+			name:      "empty host",
+			accessURL: "ss://Y2hhY2hhMjA6cGFzcw@10.0.0.5:8388",
+			host:      "",
+			expected:  "ss://Y2hhY2hhMjA6cGFzcw@10.0.0.5:8388",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			key := AccessKey{AccessURL: tt.accessURL}
+
+			// Act
+			result, err := key.AccessURLWithHost(tt.host)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestAccessKey_AccessURLWithHost_Invalid(t *testing.T) {
+	// Arrange
+	key := AccessKey{AccessURL: "https://example.com"}
+
+	// Act
+	result, err := key.AccessURLWithHost("vpn.example.com")
+
+	// Assert
+	assert.Empty(t, result)
+	assert.ErrorIs(t, err, InvalidAccessURLError)
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// APIError is a problem reported by the Outline server in an error response body,
+// e.g. {"code":"InvalidArgument","message":"Parameter `name` must be a string"}.
+type APIError struct {
+	Code    string `json:"code" yaml:"code"`       // Code is the machine-readable error code, e.g. "InvalidArgument".
+	Message string `json:"message" yaml:"message"` // Message is the human-readable description of the problem.
+}
+
+// String returns the problem as "code: message", or whichever of the two is set.
+func (e APIError) String() string {
+	switch {
+	case e.Code == "":
+		return e.Message
+	case e.Message == "":
+		return e.Code
+	default:
+		return e.Code + ": " + e.Message
+	}
+}
+
+// APIErrors holds all problems reported in an error response body. See [APIErrors.UnmarshalJSON].
+type APIErrors []APIError
+
+// UnmarshalJSON decodes either a single error object or an array of error objects.
+// Entries without a code and a message are dropped, so a body that is not an error yields no entries.
+func (e *APIErrors) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	var all []APIError
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &all); err != nil {
+			return err
+		}
+	} else {
+		var single APIError
+		if err := json.Unmarshal(data, &single); err != nil {
+			return err
+		}
+		all = []APIError{single}
+	}
+
+	*e = nil
+	for _, apiErr := range all {
+		if apiErr.Code != "" || apiErr.Message != "" {
+			*e = append(*e, apiErr)
+		}
+	}
+	return nil
+}
+
+// String returns the problems formatted by [APIError.String], separated by "; ".
+func (e APIErrors) String() string {
+	parts := make([]string, len(e))
+	for i, apiErr := range e {
+		parts[i] = apiErr.String()
+	}
+	return strings.Join(parts, "; ")
+}
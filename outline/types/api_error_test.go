@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIErrors_Unmarshal(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected APIErrors
+	}{
+		{
+			name:     "single object",
+			body:     `{"code":"InvalidArgument","message":"Parameter name must be a string"}`,
+			expected: APIErrors{{Code: "InvalidArgument", Message: "Parameter name must be a string"}},
+		},
+		{
+			name: "array",
+			body: `[{"code":"InvalidArgument","message":"bad port"},{"code":"Conflict","message":"port in use"}]`,
+			expected: APIErrors{
+				{Code: "InvalidArgument", Message: "bad port"},
+				{Code: "Conflict", Message: "port in use"},
+			},
+		},
+		{name: "object without error fields", body: `{"accessKeys":[]}`, expected: nil},
+		{name: "empty array", body: ` [] `, expected: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			var apiErrs APIErrors
+			err := json.Unmarshal([]byte(tt.body), &apiErrs)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, apiErrs)
+		})
+	}
+}
+
+func TestAPIErrors_UnmarshalInvalid(t *testing.T) {
+	// Act
+	var apiErrs APIErrors
+	err := json.Unmarshal([]byte(`"internal error"`), &apiErrs)
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestAPIErrors_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		errs     APIErrors
+		expected string
+	}{
+		{name: "code and message", errs: APIErrors{{Code: "InvalidArgument", Message: "bad port"}}, expected: "InvalidArgument: bad port"},
+		{name: "code only", errs: APIErrors{{Code: "Conflict"}}, expected: "Conflict"},
+		{name: "message only", errs: APIErrors{{Message: "port in use"}}, expected: "port in use"},
+		{
+			name:     "several",
+			errs:     APIErrors{{Code: "InvalidArgument", Message: "bad port"}, {Code: "Conflict"}},
+			expected: "InvalidArgument: bad port; Conflict",
+		},
+		{name: "none", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			s := tt.errs.String()
+
+			// Assert
+			assert.Equal(t, tt.expected, s)
+		})
+	}
+}
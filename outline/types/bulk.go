@@ -0,0 +1,7 @@
+package types
+
+// BulkResult reports the outcome of an operation applied to many access keys one by one.
+type BulkResult struct {
+	Results   map[string]error `json:"-" yaml:"-"`                 // Results holds the outcome by access key ID: nil on success, the error otherwise.
+	Succeeded int              `json:"succeeded" yaml:"succeeded"` // Succeeded is the number of access keys processed successfully.
+}
@@ -0,0 +1,17 @@
+package types
+
+// Diagnostics is a health report of the management API produced by probing harmless read endpoints.
+// URLs and error messages have the API secret masked, so the report can be shared safely.
+type Diagnostics struct {
+	Endpoints   []EndpointDiagnostic `json:"endpoints" yaml:"endpoints"`     // Endpoints holds the result of every probed endpoint.
+	SecretValid bool                 `json:"secretValid" yaml:"secretValid"` // SecretValid is true if at least one endpoint accepted the secret and answered with 2xx.
+}
+
+// EndpointDiagnostic is the result of probing a single endpoint.
+type EndpointDiagnostic struct {
+	Name       string `json:"name" yaml:"name"`                                 // Name is the client method used for the probe, e.g. "GetServerInfo".
+	URL        string `json:"url" yaml:"url"`                                   // URL is the probed URL with the secret masked.
+	Reachable  bool   `json:"reachable" yaml:"reachable"`                       // Reachable is true if the server answered with any HTTP response.
+	StatusCode int    `json:"statusCode,omitempty" yaml:"statusCode,omitempty"` // StatusCode is the HTTP status code of the response, or zero if the server was not reachable.
+	Error      string `json:"error,omitempty" yaml:"error,omitempty"`           // Error is the error reported by the probe with the secret masked, or empty on success.
+}
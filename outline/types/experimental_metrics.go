@@ -1,64 +1,131 @@
 package types
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // ExperimentalMetricsResponse represents the response containing experimental metrics
 // for the server and all access keys.
 type ExperimentalMetricsResponse struct {
-	Server     ServerMetrics      `json:"server"`     // Server contains metrics for the Outline server.
-	AccessKeys []AccessKeyMetrics `json:"accessKeys"` // AccessKeys contains metrics for each access key.
+	Server     ServerMetrics      `json:"server" yaml:"server"`         // Server contains metrics for the Outline server.
+	AccessKeys []AccessKeyMetrics `json:"accessKeys" yaml:"accessKeys"` // AccessKeys contains metrics for each access key.
 }
 
 // ServerMetrics represents metrics collected for the Outline server.
 type ServerMetrics struct {
-	Locations []LocationMetrics `json:"locations"` // Locations contains metrics grouped by geographic location.
+	Bandwidth BandwidthMetrics  `json:"bandwidth" yaml:"bandwidth"` // Bandwidth contains the current and peak bandwidth of the server.
+	Locations []LocationMetrics `json:"locations" yaml:"locations"` // Locations contains metrics grouped by geographic location.
 }
 
 // TimeMetric represents a time duration in seconds.
 type TimeMetric struct {
-	Seconds float64 `json:"seconds"` // Seconds is the duration in seconds.
+	Seconds float64 `json:"seconds" yaml:"seconds"` // Seconds is the duration in seconds.
 }
 
 // DataMetric represents an amount of data in bytes.
 type DataMetric struct {
-	Bytes float64 `json:"bytes"` // Bytes is the amount of data in bytes.
+	Bytes float64 `json:"bytes" yaml:"bytes"` // Bytes is the amount of data in bytes.
 }
 
 // BandwidthMetrics represents bandwidth usage metrics including current and peak values.
 type BandwidthMetrics struct {
-	Current BandwidthPoint `json:"current"` // Current is the current bandwidth usage at the time of measurement.
-	Peak    BandwidthPoint `json:"peak"`    // Peak is the highest bandwidth usage recorded.
+	Current BandwidthPoint `json:"current" yaml:"current"` // Current is the current bandwidth usage at the time of measurement.
+	Peak    BandwidthPoint `json:"peak" yaml:"peak"`       // Peak is the highest bandwidth usage recorded.
 }
 
 // BandwidthPoint represents a bandwidth measurement at a specific timestamp.
 type BandwidthPoint struct {
-	Data      DataMetric `json:"data"`      // Data is the amount of data transferred in this measurement.
-	Timestamp int64      `json:"timestamp"` // Timestamp is the Unix timestamp when the measurement was taken.
+	Data          DataMetric `json:"data" yaml:"data"`                                       // Data is the amount of data transferred in this measurement.
+	Timestamp     int64      `json:"timestamp" yaml:"timestamp"`                             // Timestamp is the Unix timestamp when the measurement was taken.
+	WindowSeconds float64    `json:"windowSeconds,omitempty" yaml:"windowSeconds,omitempty"` // WindowSeconds is the length of the measurement window in seconds, if reported by the server.
+}
+
+// BytesPerSecond returns the bandwidth of the measurement in bytes per second.
+// Servers that do not report a measurement window already send Data as a per-second rate,
+// so a zero or negative WindowSeconds returns Data.Bytes unchanged.
+func (b BandwidthPoint) BytesPerSecond() float64 {
+	if b.WindowSeconds <= 0 {
+		return b.Data.Bytes
+	}
+	return b.Data.Bytes / b.WindowSeconds
 }
 
 // LocationMetrics represents metrics for a specific geographic location.
 type LocationMetrics struct {
-	Location        string     `json:"location"`        // Location is the geographic location identifier.
-	ASN             *int64     `json:"asn"`             // ASN is the Autonomous System Number, if available.
-	ASOrg           *string    `json:"asOrg"`           // ASOrg is the Autonomous System organization name, if available.
-	DataTransferred DataMetric `json:"dataTransferred"` // DataTransferred is the amount of data transferred from this location.
-	TunnelTime      TimeMetric `json:"tunnelTime"`      // TunnelTime is the total tunnel time for connections from this location.
+	Location        string     `json:"location" yaml:"location"`               // Location is the geographic location identifier.
+	ASN             *int64     `json:"asn" yaml:"asn"`                         // ASN is the Autonomous System Number, if available. See [LocationMetrics.UnmarshalJSON].
+	ASOrg           *string    `json:"asOrg" yaml:"asOrg"`                     // ASOrg is the Autonomous System organization name, if available.
+	DataTransferred DataMetric `json:"dataTransferred" yaml:"dataTransferred"` // DataTransferred is the amount of data transferred from this location.
+	TunnelTime      TimeMetric `json:"tunnelTime" yaml:"tunnelTime"`           // TunnelTime is the total tunnel time for connections from this location.
+}
+
+// UnmarshalJSON decodes the location metrics, accepting the ASN as a JSON number or as a string
+// such as "AS15169" or "15169", which some servers send. A null, missing or empty ASN leaves ASN nil.
+func (l *LocationMetrics) UnmarshalJSON(data []byte) error {
+	type locationMetrics LocationMetrics // drops the method set to avoid recursion
+	aux := struct {
+		*locationMetrics
+		ASN json.RawMessage `json:"asn"`
+	}{locationMetrics: (*locationMetrics)(l)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	asn, err := parseASN(aux.ASN)
+	if err != nil {
+		return err
+	}
+	l.ASN = asn
+	return nil
+}
+
+// parseASN decodes an ASN given as a JSON number, a string with an optional "AS" prefix, or null.
+func parseASN(raw json.RawMessage) (*int64, error) {
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+
+	text := string(raw)
+	if raw[0] == '"' {
+		if err := json.Unmarshal(raw, &text); err != nil {
+			return nil, err
+		}
+		text = strings.TrimSpace(text)
+		if len(text) >= 2 && strings.EqualFold(text[:2], "AS") {
+			text = text[2:]
+		}
+		if text == "" {
+			return nil, nil
+		}
+	}
+
+	asn, err := strconv.ParseInt(text, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ASN %s: %w", raw, err)
+	}
+	return &asn, nil
 }
 
 // AccessKeyMetrics represents metrics for a specific access key.
 type AccessKeyMetrics struct {
-	AccessKeyID     int64             `json:"accessKeyId"`     // AccessKeyID is the unique identifier of the access key.
-	TunnelTime      TimeMetric        `json:"tunnelTime"`      // TunnelTime is the total time the access key has been used for tunneling.
-	DataTransferred DataMetric        `json:"dataTransferred"` // DataTransferred is the total amount of data transferred using this access key.
-	Connection      ConnectionMetrics `json:"connection"`      // Connection contains connection-related metrics for this access key.
+	AccessKeyID     int64             `json:"accessKeyId" yaml:"accessKeyId"`         // AccessKeyID is the unique identifier of the access key.
+	TunnelTime      TimeMetric        `json:"tunnelTime" yaml:"tunnelTime"`           // TunnelTime is the total time the access key has been used for tunneling.
+	DataTransferred DataMetric        `json:"dataTransferred" yaml:"dataTransferred"` // DataTransferred is the total amount of data transferred using this access key.
+	Connection      ConnectionMetrics `json:"connection" yaml:"connection"`           // Connection contains connection-related metrics for this access key.
 }
 
 // ConnectionMetrics represents connection-related metrics for an access key.
 type ConnectionMetrics struct {
-	LastTrafficSeen int64           `json:"lastTrafficSeen"` // LastTrafficSeen is the Unix timestamp of the last traffic seen for this access key.
-	PeakDeviceCount PeakDeviceCount `json:"peakDeviceCount"` // PeakDeviceCount is the peak number of devices connected simultaneously.
+	LastTrafficSeen int64           `json:"lastTrafficSeen" yaml:"lastTrafficSeen"` // LastTrafficSeen is the Unix timestamp of the last traffic seen for this access key.
+	PeakDeviceCount PeakDeviceCount `json:"peakDeviceCount" yaml:"peakDeviceCount"` // PeakDeviceCount is the peak number of devices connected simultaneously.
 }
 
 // PeakDeviceCount represents the peak number of devices connected at a specific time.
 type PeakDeviceCount struct {
-	Data      int64 `json:"data"`      // Data is the number of devices connected.
-	Timestamp int64 `json:"timestamp"` // Timestamp is the Unix timestamp when this peak was recorded.
+	Data      int64 `json:"data" yaml:"data"`           // Data is the number of devices connected.
+	Timestamp int64 `json:"timestamp" yaml:"timestamp"` // Timestamp is the Unix timestamp when this peak was recorded.
 }
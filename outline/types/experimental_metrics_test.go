@@ -0,0 +1,142 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBandwidthPoint_BytesPerSecond(t *testing.T) {
+	tests := []struct {
+		name     string
+		point    BandwidthPoint
+		expected float64
+	}{
+		{
+			name:     "ten second window",
+			point:    BandwidthPoint{Data: DataMetric{Bytes: 5000}, WindowSeconds: 10},
+			expected: 500,
+		},
+		{
+			name:     "fractional window",
+			point:    BandwidthPoint{Data: DataMetric{Bytes: 300}, WindowSeconds: 0.5},
+			expected: 600,
+		},
+		{
+			name:     "no window is already a rate",
+			point:    BandwidthPoint{Data: DataMetric{Bytes: 1234}},
+			expected: 1234,
+		},
+		{
+			name:     "zero data",
+			point:    BandwidthPoint{WindowSeconds: 30},
+			expected: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.point.BytesPerSecond(), 1e-9)
+		})
+	}
+}
+
+func TestBandwidthPoint_UnmarshalWindow(t *testing.T) {
+	tests := []struct {
+		name           string
+		data           string
+		expectedWindow float64
+	}{
+		{
+			name:           "window present",
+			data:           `{"data":{"bytes":6000},"timestamp":1700000000,"windowSeconds":60}`,
+			expectedWindow: 60,
+		},
+		{
+			name:           "window absent",
+			data:           `{"data":{"bytes":6000},"timestamp":1700000000}`,
+			expectedWindow: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var point BandwidthPoint
+			require.NoError(t, json.Unmarshal([]byte(tt.data), &point))
+			assert.Equal(t, tt.expectedWindow, point.WindowSeconds)
+			assert.Equal(t, int64(1700000000), point.Timestamp)
+		})
+	}
+}
+
+func TestServerMetrics_UnmarshalBandwidth(t *testing.T) {
+	data := `{"bandwidth":{"current":{"data":{"bytes":10},"timestamp":1},"peak":{"data":{"bytes":600},"timestamp":2,"windowSeconds":60}},"locations":[]}`
+
+	var metrics ServerMetrics
+	require.NoError(t, json.Unmarshal([]byte(data), &metrics))
+
+	assert.Equal(t, float64(10), metrics.Bandwidth.Current.BytesPerSecond())
+	assert.Equal(t, float64(10), metrics.Bandwidth.Peak.BytesPerSecond())
+}
+
+func TestLocationMetrics_UnmarshalASN(t *testing.T) {
+	asn := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name        string
+		asn         string
+		expected    *int64
+		expectedErr bool
+	}{
+		{name: "number", asn: `"asn":15169,`, expected: asn(15169)},
+		{name: "string with prefix", asn: `"asn":"AS15169",`, expected: asn(15169)},
+		{name: "string with lowercase prefix", asn: `"asn":"as13335",`, expected: asn(13335)},
+		{name: "plain string", asn: `"asn":"15169",`, expected: asn(15169)},
+		{name: "null", asn: `"asn":null,`, expected: nil},
+		{name: "absent", asn: ``, expected: nil},
+		{name: "empty string", asn: `"asn":"",`, expected: nil},
+		{name: "invalid string", asn: `"asn":"unknown",`, expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			data := `{"location":"US",` + tt.asn + `"asOrg":"Google","dataTransferred":{"bytes":100},"tunnelTime":{"seconds":5}}`
+
+			// Act
+			var metrics LocationMetrics
+			err := json.Unmarshal([]byte(data), &metrics)
+
+			// Assert
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, metrics.ASN)
+			assert.Equal(t, "US", metrics.Location)
+			require.NotNil(t, metrics.ASOrg)
+			assert.Equal(t, "Google", *metrics.ASOrg)
+			assert.Equal(t, float64(100), metrics.DataTransferred.Bytes)
+			assert.Equal(t, float64(5), metrics.TunnelTime.Seconds)
+		})
+	}
+}
+
+func TestLocationMetrics_MarshalRoundTrip(t *testing.T) {
+	// Arrange
+	asn := int64(15169)
+	metrics := LocationMetrics{Location: "US", ASN: &asn}
+
+	// Act
+	data, marshalErr := json.Marshal(metrics)
+	var decoded LocationMetrics
+	unmarshalErr := json.Unmarshal(data, &decoded)
+
+	// Assert
+	require.NoError(t, marshalErr)
+	require.NoError(t, unmarshalErr)
+	assert.Equal(t, metrics, decoded)
+}
@@ -1,6 +1,8 @@
 package types
 
 // MetricsEnabled represents whether metrics collection is enabled for the server.
+// It is used both for the response of GET /metrics/enabled and the request body of PUT /metrics/enabled,
+// which carry the value in the metricsEnabled field.
 type MetricsEnabled struct {
-	Enabled bool `json:"enabled"` // Enabled indicates if metrics are enabled (true) or disabled (false).
+	Enabled bool `json:"metricsEnabled" yaml:"metricsEnabled"` // Enabled indicates if metrics are enabled (true) or disabled (false).
 }
@@ -0,0 +1,10 @@
+package types
+
+// ServerMetricsSummary combines the transfer metrics and the experimental metrics of a server.
+type ServerMetricsSummary struct {
+	// Transfer holds the bytes transferred by each access key.
+	Transfer *MetricsTransfer `json:"transfer" yaml:"transfer"`
+	// Experimental holds the experimental server and access key metrics,
+	// or nil if they could not be retrieved.
+	Experimental *ExperimentalMetricsResponse `json:"experimental,omitempty" yaml:"experimental,omitempty"`
+}
@@ -0,0 +1,8 @@
+package types
+
+// OutlineConfig is the management API access config handed out by Outline Manager and printed by
+// the server installer, e.g. {"apiUrl":"https://1.2.3.4:1234/AbC123","certSha256":"9A2F..."}.
+type OutlineConfig struct {
+	APIURL     string `json:"apiUrl" yaml:"apiUrl"`                             // APIURL is the management API URL, ending with the secret path segment.
+	CertSHA256 string `json:"certSha256,omitempty" yaml:"certSha256,omitempty"` // CertSHA256 is the hex SHA-256 fingerprint of the server certificate, if known.
+}
@@ -0,0 +1,10 @@
+package types
+
+// RenameReport reports the outcome of applying a naming convention to the server and its access keys.
+// Every slice contains access key IDs in the order the server lists the keys.
+type RenameReport struct {
+	ServerRenamed bool             `json:"serverRenamed" yaml:"serverRenamed"` // ServerRenamed is true if the server name was changed.
+	Renamed       []string         `json:"renamed" yaml:"renamed"`             // Renamed lists the IDs of keys that were renamed.
+	Unchanged     []string         `json:"unchanged" yaml:"unchanged"`         // Unchanged lists the IDs of keys that already had the desired name.
+	Failed        map[string]error `json:"-" yaml:"-"`                         // Failed holds the errors of the failed renames by key ID.
+}
@@ -1,12 +1,87 @@
 package types
 
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"strings"
+	"sync"
+)
+
 // ServerInfoResponse represents the response containing information about the Outline server.
 type ServerInfoResponse struct {
-	Name                  string  `json:"name"`                  // Name is the human-readable name of the server.
-	ServerID              string  `json:"serverId"`              // ServerID is the unique identifier of the server.
-	MetricsEnabled        bool    `json:"metricsEnabled"`        // MetricsEnabled indicates whether metrics collection is enabled.
-	CreatedTimestampMs    float64 `json:"createdTimestampMs"`    // CreatedTimestampMs is the creation timestamp in milliseconds since epoch.
-	Version               string  `json:"version"`               // Version is the version of the Outline server software.
-	PortForNewAccessKeys  int     `json:"portForNewAccessKeys"`  // PortForNewAccessKeys is the default port for new access keys.
-	HostnameForAccessKeys string  `json:"hostnameForAccessKeys"` // HostnameForAccessKeys is the hostname used for access keys.
+	Name                  string  `json:"name" yaml:"name"`                                   // Name is the human-readable name of the server.
+	ServerID              string  `json:"serverId" yaml:"serverId"`                           // ServerID is the unique identifier of the server.
+	MetricsEnabled        bool    `json:"metricsEnabled" yaml:"metricsEnabled"`               // MetricsEnabled indicates whether metrics collection is enabled.
+	CreatedTimestampMs    float64 `json:"createdTimestampMs" yaml:"createdTimestampMs"`       // CreatedTimestampMs is the creation timestamp in milliseconds since epoch.
+	Version               string  `json:"version" yaml:"version"`                             // Version is the version of the Outline server software.
+	PortForNewAccessKeys  int     `json:"portForNewAccessKeys" yaml:"portForNewAccessKeys"`   // PortForNewAccessKeys is the default port for new access keys.
+	HostnameForAccessKeys string  `json:"hostnameForAccessKeys" yaml:"hostnameForAccessKeys"` // HostnameForAccessKeys is the hostname used for access keys.
+
+	// AccessKeyDataLimit is the server-wide data limit applied to access keys without their own limit,
+	// or nil if no server-wide limit is set.
+	AccessKeyDataLimit *Limit `json:"accessKeyDataLimit,omitempty" yaml:"accessKeyDataLimit,omitempty"`
+
+	// SupportedEncryptionMethods lists the encryption methods accepted by the server.
+	// It is empty for servers that do not report them.
+	SupportedEncryptionMethods []string `json:"supportedEncryptionMethods,omitempty" yaml:"supportedEncryptionMethods,omitempty"`
+
+	// extra holds the fields of the response that the struct does not model; see [ServerInfoResponse.Extra].
+	extra map[string]json.RawMessage
+}
+
+// UnmarshalJSON decodes the server information and keeps the fields it does not model,
+// which newer server versions may send, for [ServerInfoResponse.Extra].
+func (s *ServerInfoResponse) UnmarshalJSON(data []byte) error {
+	type serverInfoResponse ServerInfoResponse // drops the method set to avoid recursion
+	if err := json.Unmarshal(data, (*serverInfoResponse)(s)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	// encoding/json matches field names case-insensitively, so must the filter.
+	maps.DeleteFunc(fields, func(name string, _ json.RawMessage) bool {
+		for _, known := range serverInfoFieldNames() {
+			if strings.EqualFold(name, known) {
+				return true
+			}
+		}
+		return false
+	})
+	s.extra = nil
+	if len(fields) > 0 {
+		s.extra = fields
+	}
+	return nil
+}
+
+// Extra returns the fields of the server response that [ServerInfoResponse] does not model,
+// keyed by JSON name, e.g. for inspecting features of newer server versions.
+// It returns nil if the response held no such field. The returned map is a copy and is safe to modify.
+func (s *ServerInfoResponse) Extra() map[string]json.RawMessage {
+	return maps.Clone(s.extra)
+}
+
+// serverInfoFieldNames returns the JSON names of the fields modeled by [ServerInfoResponse].
+var serverInfoFieldNames = sync.OnceValue(func() []string {
+	var names []string
+	for _, field := range reflect.VisibleFields(reflect.TypeFor[ServerInfoResponse]()) {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if field.IsExported() && name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+})
+
+// ServerConnectionInfo holds the public details clients need to connect to the Outline server,
+// for example when rendering setup instructions.
+type ServerConnectionInfo struct {
+	Name     string `json:"name" yaml:"name"`         // Name is the human-readable name of the server.
+	Version  string `json:"version" yaml:"version"`   // Version is the version of the Outline server software.
+	Hostname string `json:"hostname" yaml:"hostname"` // Hostname is the hostname used in access keys.
+	Port     int    `json:"port" yaml:"port"`         // Port is the default port for new access keys.
 }
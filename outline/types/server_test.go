@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerInfoResponse_Extra(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected map[string]json.RawMessage
+	}{
+		{
+			name: "unmodeled fields retained",
+			data: `{"name":"My Server","serverId":"abc","experimental":{"asn":true},"tunnelTimeLimit":60}`,
+			expected: map[string]json.RawMessage{
+				"experimental":    json.RawMessage(`{"asn":true}`),
+				"tunnelTimeLimit": json.RawMessage(`60`),
+			},
+		},
+		{
+			name:     "only modeled fields",
+			data:     `{"name":"My Server","serverId":"abc","accessKeyDataLimit":{"bytes":100}}`,
+			expected: nil,
+		},
+		{
+			name:     "modeled field in other case",
+			data:     `{"Name":"My Server","SERVERID":"abc"}`,
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			var info ServerInfoResponse
+			err := json.Unmarshal([]byte(tt.data), &info)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, "My Server", info.Name)
+			assert.Equal(t, "abc", info.ServerID)
+			assert.Equal(t, tt.expected, info.Extra())
+		})
+	}
+}
+
+func TestServerInfoResponse_ExtraIsCopy(t *testing.T) {
+	// Arrange
+	var info ServerInfoResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"name":"My Server","future":1}`), &info))
+
+	// Act
+	extra := info.Extra()
+	delete(extra, "future")
+
+	// Assert
+	assert.Equal(t, map[string]json.RawMessage{"future": json.RawMessage(`1`)}, info.Extra())
+}
+
+func TestServerInfoResponse_UnmarshalInvalid(t *testing.T) {
+	// Act
+	var info ServerInfoResponse
+	err := json.Unmarshal([]byte(`{"name":1}`), &info)
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,197 @@
+package types
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// InvalidShadowsocksConfigError indicates that a [ShadowsocksConfig] cannot be encoded as an access URL.
+var InvalidShadowsocksConfigError = errors.New("invalid shadowsocks config")
+
+// ShadowsocksConfigError reports the field of a [ShadowsocksConfig] rejected by [BuildAccessURL].
+// It wraps [InvalidShadowsocksConfigError].
+type ShadowsocksConfigError struct {
+	Field  string // Field is the name of the rejected field, e.g. "Method".
+	Reason string // Reason describes why the field was rejected.
+}
+
+// Error returns a message naming the rejected field and the reason.
+func (e *ShadowsocksConfigError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", InvalidShadowsocksConfigError, e.Field, e.Reason)
+}
+
+// Unwrap returns [InvalidShadowsocksConfigError] for use with [errors.Is].
+func (e *ShadowsocksConfigError) Unwrap() error {
+	return InvalidShadowsocksConfigError
+}
+
+// ShadowsocksConfig holds the connection parameters encoded in an ss:// access URL.
+type ShadowsocksConfig struct {
+	Method   string `json:"method" yaml:"method"`               // Method is the encryption method, e.g. "chacha20-ietf-poly1305".
+	Password string `json:"password" yaml:"password"`           // Password is the password used together with Method.
+	Host     string `json:"host" yaml:"host"`                   // Host is the hostname or IP address of the server, without brackets.
+	Port     int    `json:"port" yaml:"port"`                   // Port is the TCP/UDP port of the server.
+	Tag      string `json:"tag,omitempty" yaml:"tag,omitempty"` // Tag is the decoded #fragment naming the server, or empty if there is none.
+}
+
+// ParseAccessURL decodes the ss:// access URL of the key into its connection parameters.
+// It understands the SIP002 form, ss://base64(method:password)@host:port/?plugin#tag, whose user info
+// may also be plain percent-encoded method:password, and the legacy form, ss://base64(method:password@host:port)#tag.
+// Both standard and URL-safe base64, with or without padding, are accepted. The tag is percent-decoded.
+//
+// It returns an error wrapping [InvalidAccessURLError] that describes what is malformed.
+func (k *AccessKey) ParseAccessURL() (*ShadowsocksConfig, error) {
+	rest, ok := cutPrefixFold(k.AccessURL, "ss://")
+	if !ok {
+		return nil, fmt.Errorf("%w: %q does not start with ss://", InvalidAccessURLError, k.AccessURL)
+	}
+
+	rest, fragment, _ := strings.Cut(rest, "#")
+	tag, err := url.PathUnescape(fragment)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid tag: %w", InvalidAccessURLError, err)
+	}
+
+	var cfg *ShadowsocksConfig
+	if strings.Contains(rest, "@") {
+		cfg, err = parseSIP002(k.AccessURL)
+	} else {
+		cfg, err = parseLegacyAccessURL(rest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	cfg.Tag = tag
+	return cfg, nil
+}
+
+// BuildAccessURL encodes cfg as a SIP002 access URL, ss://base64(method:password)@host:port#tag,
+// with URL-safe unpadded base64 user info and a percent-encoded tag. An empty tag is omitted.
+// The result is understood by [AccessKey.ParseAccessURL], which returns cfg back.
+//
+// It returns [*ShadowsocksConfigError] if the method is not one of [ValidEncryptionMethods],
+// the host is empty or the port is not from 1 through 65535.
+func BuildAccessURL(cfg ShadowsocksConfig) (string, error) {
+	if !IsValidEncryptionMethod(cfg.Method) {
+		return "", &ShadowsocksConfigError{Field: "Method", Reason: fmt.Sprintf("unsupported encryption method %q", cfg.Method)}
+	}
+	if cfg.Host == "" {
+		return "", &ShadowsocksConfigError{Field: "Host", Reason: "host is empty"}
+	}
+	if cfg.Port < 1 || cfg.Port > 65535 {
+		return "", &ShadowsocksConfigError{Field: "Port", Reason: fmt.Sprintf("port %d is not from 1 through 65535", cfg.Port)}
+	}
+
+	u := url.URL{
+		Scheme:   "ss",
+		User:     url.User(base64.RawURLEncoding.EncodeToString([]byte(cfg.Method + ":" + cfg.Password))),
+		Host:     net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)),
+		Fragment: cfg.Tag,
+	}
+	return u.String(), nil
+}
+
+// parseSIP002 decodes an access URL of the form ss://userinfo@host:port/?plugin#tag.
+func parseSIP002(raw string) (*ShadowsocksConfig, error) {
+	u, err := parseAccessURL(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	userInfo := u.User.Username()
+	if password, ok := u.User.Password(); ok {
+		userInfo += ":" + password
+	} else if userInfo, err = decodeBase64(userInfo); err != nil {
+		return nil, fmt.Errorf("%w: user info is not base64: %w", InvalidAccessURLError, err)
+	}
+
+	cfg, err := splitMethodPassword(userInfo)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Port, err = parsePort(u.Port()); err != nil {
+		return nil, err
+	}
+	cfg.Host = u.Hostname()
+	return cfg, nil
+}
+
+// parseLegacyAccessURL decodes the payload of an access URL of the form ss://base64(method:password@host:port).
+func parseLegacyAccessURL(payload string) (*ShadowsocksConfig, error) {
+	payload, _, _ = strings.Cut(payload, "?")
+	decoded, err := decodeBase64(strings.TrimSuffix(payload, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: legacy payload is not base64: %w", InvalidAccessURLError, err)
+	}
+
+	at := strings.LastIndex(decoded, "@")
+	if at < 0 {
+		return nil, fmt.Errorf("%w: legacy payload has no host", InvalidAccessURLError)
+	}
+	cfg, err := splitMethodPassword(decoded[:at])
+	if err != nil {
+		return nil, err
+	}
+
+	host, port, err := net.SplitHostPort(decoded[at+1:])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", InvalidAccessURLError, err)
+	}
+	if host == "" {
+		return nil, fmt.Errorf("%w: missing host or port", InvalidAccessURLError)
+	}
+	if cfg.Port, err = parsePort(port); err != nil {
+		return nil, err
+	}
+	cfg.Host = host
+	return cfg, nil
+}
+
+// splitMethodPassword splits "method:password"; the password may contain colons.
+func splitMethodPassword(userInfo string) (*ShadowsocksConfig, error) {
+	method, password, ok := strings.Cut(userInfo, ":")
+	if !ok || method == "" {
+		return nil, fmt.Errorf("%w: user info is not method:password", InvalidAccessURLError)
+	}
+	return &ShadowsocksConfig{Method: method, Password: password}, nil
+}
+
+// parsePort parses a port number from 1 through 65535.
+func parsePort(port string) (int, error) {
+	n, err := strconv.Atoi(port)
+	if err != nil || n < 1 || n > 65535 {
+		return 0, fmt.Errorf("%w: invalid port %q", InvalidAccessURLError, port)
+	}
+	return n, nil
+}
+
+// decodeBase64 decodes s in standard or URL-safe base64, with or without padding.
+func decodeBase64(s string) (string, error) {
+	s = strings.TrimRight(s, "=")
+	enc := base64.RawStdEncoding
+	if strings.ContainsAny(s, "-_") {
+		enc = base64.RawURLEncoding
+	}
+	b, err := enc.DecodeString(s)
+	return string(b), err
+}
+
+// cutPrefixFold is [strings.CutPrefix] with a case-insensitive prefix.
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+// ParsedAccessKey pairs an access key with the connection parameters decoded from its access URL.
+type ParsedAccessKey struct {
+	Key    *AccessKey         `json:"key" yaml:"key"`                           // Key is the access key.
+	Config *ShadowsocksConfig `json:"config,omitempty" yaml:"config,omitempty"` // Config holds the decoded access URL, or nil if it could not be parsed.
+	Err    error              `json:"-" yaml:"-"`                               // Err is the error of [AccessKey.ParseAccessURL], or nil if the access URL was parsed.
+}
@@ -0,0 +1,215 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessKey_ParseAccessURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		accessURL string
+		expected  *ShadowsocksConfig
+	}{
+		{
+			name:      "SIP002 with query and tag",
+			accessURL: "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@203.0.113.1:8388/?outline=1#Work",
+			expected: &ShadowsocksConfig{
+				Method: "chacha20-ietf-poly1305", Password: "pass", Host: "203.0.113.1", Port: 8388, Tag: "Work",
+			},
+		},
+		{
+			name:      "SIP002 without fragment",
+			accessURL: "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@203.0.113.1:8388",
+			expected: &ShadowsocksConfig{
+				Method: "chacha20-ietf-poly1305", Password: "pass", Host: "203.0.113.1", Port: 8388,
+			},
+		},
+		{
+			name:      "SIP002 with URL-encoded tag",
+			accessURL: "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@example.com:443#Work%20Laptop%20%F0%9F%92%BB",
+			expected: &ShadowsocksConfig{
+				Method: "chacha20-ietf-poly1305", Password: "pass", Host: "example.com", Port: 443, Tag: "Work Laptop 💻",
+			},
+		},
+		{
+			name:      "SIP002 with padded user info",
+			accessURL: "ss://YWVzLTI1Ni1nY206c2VjcmV0%3D@203.0.113.1:8388",
+			expected: &ShadowsocksConfig{
+				Method: "aes-256-gcm", Password: "secret", Host: "203.0.113.1", Port: 8388,
+			},
+		},
+		{
+			name:      "SIP002 with plain user info",
+			accessURL: "ss://2022-blake3-aes-128-gcm:c2VjcmV0%2Bkey@[2001:db8::1]:8388#IPv6",
+			expected: &ShadowsocksConfig{
+				Method: "2022-blake3-aes-128-gcm", Password: "c2VjcmV0+key", Host: "2001:db8::1", Port: 8388, Tag: "IPv6",
+			},
+		},
+		{
+			name:      "legacy with tag",
+			accessURL: "ss://YWVzLTI1Ni1nY206cEBzczp3b3JkQDIwMy4wLjExMy4xOjgzODg=#Home%20Router",
+			expected: &ShadowsocksConfig{
+				Method: "aes-256-gcm", Password: "p@ss:word", Host: "203.0.113.1", Port: 8388, Tag: "Home Router",
+			},
+		},
+		{
+			name:      "legacy unpadded IPv6 without fragment",
+			accessURL: "ss://YWVzLTI1Ni1nY206c2VjcmV0QFsyMDAxOmRiODo6MV06NDQz",
+			expected: &ShadowsocksConfig{
+				Method: "aes-256-gcm", Password: "secret", Host: "2001:db8::1", Port: 443,
+			},
+		},
+		{
+			name:      "uppercase scheme",
+			accessURL: "SS://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@203.0.113.1:8388",
+			expected: &ShadowsocksConfig{
+				Method: "chacha20-ietf-poly1305", Password: "pass", Host: "203.0.113.1", Port: 8388,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			key := AccessKey{AccessURL: tt.accessURL}
+
+			// Act
+			cfg, err := key.ParseAccessURL()
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, cfg)
+		})
+	}
+}
+
+func TestAccessKey_ParseAccessURL_Malformed(t *testing.T) {
+	tests := []struct {
+		name        string
+		accessURL   string
+		expectedMsg string
+	}{
+		{name: "empty", accessURL: "", expectedMsg: "does not start with ss://"},
+		{name: "other scheme", accessURL: "https://203.0.113.1:8388", expectedMsg: "does not start with ss://"},
+		{name: "invalid tag escape", accessURL: "ss://YWVzLTI1Ni1nY206c2VjcmV0@203.0.113.1:8388#%zz", expectedMsg: "invalid tag"},
+		{name: "user info not base64", accessURL: "ss://not*base64@203.0.113.1:8388", expectedMsg: "user info is not base64"},
+		{name: "user info without password", accessURL: "ss://bm9jb2xvbg@203.0.113.1:8388", expectedMsg: "user info is not method:password"},
+		{name: "SIP002 missing port", accessURL: "ss://YWVzLTI1Ni1nY206c2VjcmV0@203.0.113.1", expectedMsg: "missing host or port"},
+		{name: "SIP002 port out of range", accessURL: "ss://YWVzLTI1Ni1nY206c2VjcmV0@203.0.113.1:70000", expectedMsg: `invalid port "70000"`},
+		{name: "legacy not base64", accessURL: "ss://not*base64#tag", expectedMsg: "legacy payload is not base64"},
+		{name: "legacy without host", accessURL: "ss://YWVzLTI1Ni1nY206c2VjcmV0", expectedMsg: "legacy payload has no host"},
+		{name: "legacy missing port", accessURL: "ss://YWVzLTI1Ni1nY206c2VjcmV0QDIwMy4wLjExMy4x", expectedMsg: "missing port"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			key := AccessKey{AccessURL: tt.accessURL}
+
+			// Act
+			cfg, err := key.ParseAccessURL()
+
+			// Assert
+			require.ErrorIs(t, err, InvalidAccessURLError)
+			assert.ErrorContains(t, err, tt.expectedMsg)
+			assert.Nil(t, cfg)
+		})
+	}
+}
+
+func TestBuildAccessURL(t *testing.T) {
+	// Arrange
+	cfg := ShadowsocksConfig{
+		Method: MethodChaCha20IETFPoly1305, Password: "pass", Host: "203.0.113.1", Port: 8388, Tag: "Work Laptop",
+	}
+
+	// Act
+	accessURL, err := BuildAccessURL(cfg)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@203.0.113.1:8388#Work%20Laptop", accessURL)
+}
+
+func TestBuildAccessURL_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  ShadowsocksConfig
+	}{
+		{
+			name: "no tag",
+			cfg:  ShadowsocksConfig{Method: MethodAES128GCM, Password: "secret", Host: "example.com", Port: 443},
+		},
+		{
+			name: "tag with reserved characters",
+			cfg: ShadowsocksConfig{
+				Method: MethodAES256GCM, Password: "secret", Host: "203.0.113.1", Port: 8388, Tag: "Team #1 / 100% 💻",
+			},
+		},
+		{
+			name: "password with special characters",
+			cfg: ShadowsocksConfig{
+				Method: MethodChaCha20IETFPoly1305, Password: "p@ss:w/rd?+=", Host: "203.0.113.1", Port: 1, Tag: "a",
+			},
+		},
+		{
+			name: "IPv6 host",
+			cfg:  ShadowsocksConfig{Method: MethodAES128GCM, Password: "secret", Host: "2001:db8::1", Port: 65535},
+		},
+		{
+			name: "empty password",
+			cfg:  ShadowsocksConfig{Method: MethodAES128GCM, Host: "203.0.113.1", Port: 8388},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			accessURL, buildErr := BuildAccessURL(tt.cfg)
+			key := AccessKey{AccessURL: accessURL}
+			cfg, parseErr := key.ParseAccessURL()
+
+			// Assert
+			require.NoError(t, buildErr)
+			require.NoError(t, parseErr)
+			assert.Equal(t, tt.cfg, *cfg)
+		})
+	}
+}
+
+func TestBuildAccessURL_Invalid(t *testing.T) {
+	valid := ShadowsocksConfig{Method: MethodAES128GCM, Password: "secret", Host: "203.0.113.1", Port: 8388}
+
+	tests := []struct {
+		name          string
+		modify        func(cfg *ShadowsocksConfig)
+		expectedField string
+	}{
+		{name: "unsupported method", modify: func(cfg *ShadowsocksConfig) { cfg.Method = "rc4-md5" }, expectedField: "Method"},
+		{name: "empty method", modify: func(cfg *ShadowsocksConfig) { cfg.Method = "" }, expectedField: "Method"},
+		{name: "empty host", modify: func(cfg *ShadowsocksConfig) { cfg.Host = "" }, expectedField: "Host"},
+		{name: "zero port", modify: func(cfg *ShadowsocksConfig) { cfg.Port = 0 }, expectedField: "Port"},
+		{name: "port too large", modify: func(cfg *ShadowsocksConfig) { cfg.Port = 65536 }, expectedField: "Port"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			cfg := valid
+			tt.modify(&cfg)
+
+			// Act
+			accessURL, err := BuildAccessURL(cfg)
+
+			// Assert
+			assert.Empty(t, accessURL)
+			require.ErrorIs(t, err, InvalidShadowsocksConfigError)
+			var cfgErr *ShadowsocksConfigError
+			require.ErrorAs(t, err, &cfgErr)
+			assert.Equal(t, tt.expectedField, cfgErr.Field)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+// ServerState is a snapshot of the server configuration and its access keys,
+// used to back up a server and restore it later.
+type ServerState struct {
+	Name                  string       `json:"name" yaml:"name"`                                                 // Name is the human-readable name of the server.
+	HostnameForAccessKeys string       `json:"hostnameForAccessKeys" yaml:"hostnameForAccessKeys"`               // HostnameForAccessKeys is the hostname used for access keys.
+	PortForNewAccessKeys  int          `json:"portForNewAccessKeys" yaml:"portForNewAccessKeys"`                 // PortForNewAccessKeys is the default port for new access keys.
+	MetricsEnabled        bool         `json:"metricsEnabled" yaml:"metricsEnabled"`                             // MetricsEnabled indicates whether metrics collection is enabled.
+	AccessKeyDataLimit    *Limit       `json:"accessKeyDataLimit,omitempty" yaml:"accessKeyDataLimit,omitempty"` // AccessKeyDataLimit is the server-wide data limit, or nil if none is set.
+	AccessKeys            []*AccessKey `json:"accessKeys" yaml:"accessKeys"`                                     // AccessKeys are the access keys of the server, including their passwords and data limits.
+}
+
+// ImportOptions controls how a [ServerState] is restored.
+type ImportOptions struct {
+	// KeepIDs recreates access keys with their original IDs instead of IDs assigned by the server.
+	// Importing then fails if a key with the same ID already exists.
+	KeepIDs bool `json:"keepIds" yaml:"keepIds"`
+
+	// SkipServerConfig leaves the server name, hostname, default port, metrics setting
+	// and server-wide data limit unchanged and only recreates the access keys.
+	SkipServerConfig bool `json:"skipServerConfig" yaml:"skipServerConfig"`
+}
@@ -0,0 +1,50 @@
+package types
+
+// DesiredKey describes the desired configuration of an access key
+// used when reconciling the server state with a declarative specification.
+// Keys are matched against existing access keys by Name.
+type DesiredKey struct {
+	Name     string `json:"name" yaml:"name"`                             // Name is the human-readable name that identifies the key during reconciliation.
+	Method   string `json:"method,omitempty" yaml:"method,omitempty"`     // Method is the desired encryption method. An empty value accepts any method.
+	Password string `json:"password,omitempty" yaml:"password,omitempty"` // Password is the password used when the key has to be created. It is not compared for existing keys.
+	Port     uint16 `json:"port,omitempty" yaml:"port,omitempty"`         // Port is the desired port. A zero value accepts any port.
+	Limit    *Limit `json:"limit,omitempty" yaml:"limit,omitempty"`       // Limit is the desired data transfer limit. A nil value means the key must be unlimited.
+}
+
+// ConfigEquals reports whether the access key k already satisfies the desired configuration.
+// It compares the name, port, encryption method and data limit.
+func (d DesiredKey) ConfigEquals(k *AccessKey) bool {
+	if k == nil || k.Name != d.Name {
+		return false
+	}
+	if d.Method != "" && k.Method != d.Method {
+		return false
+	}
+	if d.Port != 0 && k.Port != int(d.Port) {
+		return false
+	}
+
+	return d.limitEquals(k.Limit)
+}
+
+// limitEquals reports whether the limit matches the desired limit,
+// treating nil and a zero limit as equivalent.
+func (d DesiredKey) limitEquals(limit *Limit) bool {
+	var want, got uint64
+	if d.Limit != nil {
+		want = d.Limit.Bytes
+	}
+	if limit != nil {
+		got = limit.Bytes
+	}
+	return want == got
+}
+
+// SyncResult reports the outcome of a reconciliation of access keys.
+// Every slice contains access key IDs.
+type SyncResult struct {
+	Created   []string `json:"created" yaml:"created"`     // Created lists the IDs of keys created because they were missing.
+	Updated   []string `json:"updated" yaml:"updated"`     // Updated lists the IDs of keys whose configuration was changed.
+	Deleted   []string `json:"deleted" yaml:"deleted"`     // Deleted lists the IDs of keys removed because they were not desired.
+	Unchanged []string `json:"unchanged" yaml:"unchanged"` // Unchanged lists the IDs of keys that already matched the desired configuration.
+}
@@ -0,0 +1,18 @@
+package types
+
+// AccessKeyUsage pairs an access key with the number of bytes it has transferred.
+type AccessKeyUsage struct {
+	Key              *AccessKey `json:"key" yaml:"key"`                           // Key is the access key.
+	BytesTransferred int64      `json:"bytesTransferred" yaml:"bytesTransferred"` // BytesTransferred is the number of bytes transferred by the key, zero if no usage was recorded.
+}
+
+// Utilization summarizes how much of the data limits provisioned on a server has been used.
+// Keys without their own limit are bound by the server-wide limit, if any.
+type Utilization struct {
+	TransferredBytes int64   `json:"transferredBytes" yaml:"transferredBytes"` // TransferredBytes is the number of bytes transferred by all access keys.
+	LimitBytes       uint64  `json:"limitBytes" yaml:"limitBytes"`             // LimitBytes is the sum of the data limits of the limited access keys.
+	UsedPercent      float64 `json:"usedPercent" yaml:"usedPercent"`           // UsedPercent is the bytes transferred by the limited keys as a percentage of LimitBytes, zero if Unlimited; it exceeds 100 when keys overrun their limits.
+	Unlimited        bool    `json:"unlimited" yaml:"unlimited"`               // Unlimited is true if no data limit is provisioned, i.e. LimitBytes is zero.
+	OverLimitKeys    int     `json:"overLimitKeys" yaml:"overLimitKeys"`       // OverLimitKeys is the number of access keys that have used up their data limit.
+	UnlimitedKeys    int     `json:"unlimitedKeys" yaml:"unlimitedKeys"`       // UnlimitedKeys is the number of access keys without any data limit.
+}
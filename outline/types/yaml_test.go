@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAML_RoundTrip(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		in   any
+		out  any
+	}{
+		{
+			name: "server info",
+			in: &ServerInfoResponse{
+				Name: "eu-1", ServerID: "srv-1", MetricsEnabled: true, CreatedTimestampMs: 1700000000000,
+				Version: "1.9.0", PortForNewAccessKeys: 8388, HostnameForAccessKeys: "vpn.example.com",
+				AccessKeyDataLimit: &Limit{Bytes: 1024}, SupportedEncryptionMethods: []string{MethodAES256GCM},
+			},
+			out: &ServerInfoResponse{},
+		},
+		{
+			name: "server state",
+			in: &ServerState{
+				Name: "eu-1", HostnameForAccessKeys: "vpn.example.com", PortForNewAccessKeys: 8388,
+				AccessKeys: []*AccessKey{{
+					ID: "1", Name: "alice", Password: "pass", Port: 8388, Method: MethodChaCha20IETFPoly1305,
+					AccessURL: "ss://Y2hhY2hhMjAtaWV0Zi1wb2x5MTMwNTpwYXNz@vpn.example.com:8388",
+					Limit:     &Limit{Bytes: 5}, CreatedAt: &created,
+				}},
+			},
+			out: &ServerState{},
+		},
+		{
+			name: "transfer metrics",
+			in:   &MetricsTransfer{BytesTransferredByUserID: map[string]int64{"1": 42, "2": 0}},
+			out:  &MetricsTransfer{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			data, err := yaml.Marshal(tt.in)
+			require.NoError(t, err)
+			err = yaml.Unmarshal(data, tt.out)
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.in, tt.out)
+		})
+	}
+}
+
+func TestYAML_FieldNames(t *testing.T) {
+	// Arrange
+	info := ServerInfoResponse{Name: "eu-1", ServerID: "srv-1", PortForNewAccessKeys: 8388, HostnameForAccessKeys: "vpn.example.com"}
+
+	// Act
+	data, err := yaml.Marshal(info)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, `name: eu-1
+serverId: srv-1
+metricsEnabled: false
+createdTimestampMs: 0
+version: ""
+portForNewAccessKeys: 8388
+hostnameForAccessKeys: vpn.example.com
+`, string(data))
+}
+
+func TestYAML_JSONUnchanged(t *testing.T) {
+	// Arrange
+	body := `{"name":"eu-1","serverId":"srv-1","metricsEnabled":true,"portForNewAccessKeys":8388,` +
+		`"hostnameForAccessKeys":"vpn.example.com","accessKeyDataLimit":{"bytes":1024}}`
+
+	// Act
+	var info ServerInfoResponse
+	err := json.Unmarshal([]byte(body), &info)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, "srv-1", info.ServerID)
+	assert.True(t, info.MetricsEnabled)
+	assert.Equal(t, 8388, info.PortForNewAccessKeys)
+	assert.Equal(t, "vpn.example.com", info.HostnameForAccessKeys)
+	assert.Equal(t, &Limit{Bytes: 1024}, info.AccessKeyDataLimit)
+	assert.Empty(t, info.Extra())
+}
@@ -1,15 +1,76 @@
 package outline
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
 )
 
+// unmarshalConfig holds the settings applied by unmarshalOption values.
+type unmarshalConfig struct {
+	strict bool
+	stats  *unmarshalStats
+}
+
+// unmarshalOption configures how a response body is decoded.
+type unmarshalOption func(*unmarshalConfig)
+
+// withStrictJSON rejects bodies with data after the first JSON value when strict is true.
+func withStrictJSON(strict bool) unmarshalOption {
+	return func(cfg *unmarshalConfig) {
+		cfg.strict = strict
+	}
+}
+
+// withUnmarshalStats counts decoding failures in stats.
+func withUnmarshalStats(stats *unmarshalStats) unmarshalOption {
+	return func(cfg *unmarshalConfig) {
+		cfg.stats = stats
+	}
+}
+
+// unmarshalOptions returns the decoding options configured on the client.
+func (c *Client) unmarshalOptions() []unmarshalOption {
+	return []unmarshalOption{withStrictJSON(c.strictJSON), withUnmarshalStats(&c.unmarshalStats)}
+}
+
+// unmarshalStats counts decoding failures by target type.
+type unmarshalStats struct {
+	counts sync.Map // map[string]*atomic.Int64
+}
+
+// add increments the failure counter of typeStr.
+func (s *unmarshalStats) add(typeStr string) {
+	counter, _ := s.counts.LoadOrStore(typeStr, new(atomic.Int64))
+	counter.(*atomic.Int64).Add(1)
+}
+
+// snapshot returns the current failure counts.
+func (s *unmarshalStats) snapshot() map[string]int64 {
+	counts := make(map[string]int64)
+	s.counts.Range(func(key, value any) bool {
+		counts[key.(string)] = value.(*atomic.Int64).Load()
+		return true
+	})
+	return counts
+}
+
+// UnmarshalErrorStats returns the number of response bodies the client failed to decode,
+// keyed by the target type reported by [UnmarshalError.Type], e.g. "*types.AccessKey".
+// A growing count usually means the server changed the shape of a response.
+// The returned map is a snapshot and is safe to modify.
+func (c *Client) UnmarshalErrorStats() map[string]int64 {
+	return c.unmarshalStats.snapshot()
+}
+
 // unmarshalJSONWithError unmarshals JSON data into a new instance of type T.
 // It returns a pointer to the unmarshaled value or an error if unmarshaling fails.
-func unmarshalJSONWithError[T any](data []byte) (*T, error) {
+func unmarshalJSONWithError[T any](data []byte, opts ...unmarshalOption) (*T, error) {
 	target := new(T)
-	if err := unmarshalWithErrorInternal(data, target, fmt.Sprintf("%T", target)); err != nil {
+	if err := unmarshalWithErrorInternal(data, target, fmt.Sprintf("%T", target), opts...); err != nil {
 		return nil, err
 	}
 	return target, nil
@@ -17,25 +78,56 @@ func unmarshalJSONWithError[T any](data []byte) (*T, error) {
 
 // unmarshalAccessKeysResponse unmarshals the access keys response from JSON.
 // It extracts the accessKeys array from the response wrapper.
-func unmarshalAccessKeysResponse[T any](data []byte) ([]*T, error) {
+// A missing or null accessKeys field yields an empty non-nil slice, the same as an empty array.
+func unmarshalAccessKeysResponse[T any](data []byte, opts ...unmarshalOption) ([]*T, error) {
 	var wrapper struct {
 		AccessKeys []*T `json:"accessKeys"`
 	}
-	if err := unmarshalWithErrorInternal(data, &wrapper, fmt.Sprintf("[]*%T", *new(T))); err != nil {
+	if err := unmarshalWithErrorInternal(data, &wrapper, fmt.Sprintf("[]*%T", *new(T)), opts...); err != nil {
 		return nil, err
 	}
+	if wrapper.AccessKeys == nil {
+		return []*T{}, nil
+	}
 	return wrapper.AccessKeys, nil
 }
 
 // unmarshalWithErrorInternal performs the actual JSON unmarshaling with error handling.
 // It checks for empty data and wraps unmarshaling errors with additional context.
-func unmarshalWithErrorInternal(data []byte, target any, typeStr string) error {
+func unmarshalWithErrorInternal(data []byte, target any, typeStr string, opts ...unmarshalOption) error {
+	var cfg unmarshalConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	err := decodeJSON(data, target, typeStr, cfg)
+	if err != nil && cfg.stats != nil {
+		cfg.stats.add(typeStr)
+	}
+	return err
+}
+
+// decodeJSON decodes data into target according to cfg.
+func decodeJSON(data []byte, target any, typeStr string, cfg unmarshalConfig) error {
 	if len(data) == 0 {
 		return errUnmarshalEmptyBody(typeStr)
 	}
 
-	if err := json.Unmarshal(data, target); err != nil {
+	if !cfg.strict {
+		if err := json.Unmarshal(data, target); err != nil {
+			return errUnmarshal(data, typeStr, err)
+		}
+		return nil
+	}
+
+	// json.Unmarshal also rejects trailing data, but only as a generic syntax error;
+	// decoding the first value separately reports it as [UnmarshalTrailingDataError].
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if err := dec.Decode(target); err != nil {
 		return errUnmarshal(data, typeStr, err)
 	}
+	if _, err := dec.Token(); err != io.EOF {
+		return errUnmarshal(data, typeStr, UnmarshalTrailingDataError)
+	}
 	return nil
 }
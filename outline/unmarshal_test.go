@@ -1,9 +1,13 @@
 package outline
 
 import (
+	"context"
+	"net/http"
 	"testing"
 
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // test types for unmarshal tests
@@ -114,18 +118,27 @@ func TestUnmarshalAccessKeysResponse_Success(t *testing.T) {
 	}
 }
 
-func TestUnmarshalAccessKeysResponse_EmptyArray(t *testing.T) {
-	data := []byte(`{"accessKeys":[]}`)
-	res, err := unmarshalAccessKeysResponse[testPerson](data)
-	assert.NoError(t, err)
-	assert.Len(t, res, 0)
-}
+func TestUnmarshalAccessKeysResponse_NoKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "empty array", data: `{"accessKeys":[]}`},
+		{name: "missing field", data: `{}`},
+		{name: "null field", data: `{"accessKeys":null}`},
+	}
 
-func TestUnmarshalAccessKeysResponse_MissingField(t *testing.T) {
-	data := []byte(`{}`)
-	res, err := unmarshalAccessKeysResponse[testPerson](data)
-	assert.NoError(t, err)
-	assert.Nil(t, res)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			res, err := unmarshalAccessKeysResponse[testPerson]([]byte(tt.data))
+
+			// Assert
+			require.NoError(t, err)
+			assert.NotNil(t, res)
+			assert.Empty(t, res)
+		})
+	}
 }
 
 func TestUnmarshalAccessKeysResponse_EmptyData(t *testing.T) {
@@ -149,3 +162,109 @@ func TestUnmarshalAccessKeysResponse_InvalidJSON(t *testing.T) {
 	assert.ErrorIs(t, err, ClientOutlineError)
 	assert.ErrorIs(t, err, UnmarshalFailedError)
 }
+
+func TestUnmarshalJSONWithError_TrailingData(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		strict      bool
+		expectedErr error
+	}{
+		{name: "lenient object followed by garbage", data: `{"name":"Alice","age":30}garbage`, strict: false, expectedErr: UnmarshalFailedError},
+		{name: "strict object followed by garbage", data: `{"name":"Alice","age":30}garbage`, strict: true, expectedErr: UnmarshalTrailingDataError},
+		{name: "strict double-written body", data: `{"name":"Alice"}{"name":"Bob"}`, strict: true, expectedErr: UnmarshalTrailingDataError},
+		{name: "strict trailing whitespace", data: "{\"name\":\"Alice\"}\n  ", strict: true},
+		{name: "lenient trailing whitespace", data: "{\"name\":\"Alice\"}\n  ", strict: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			res, err := unmarshalJSONWithError[testPerson]([]byte(tt.data), withStrictJSON(tt.strict))
+
+			// Assert
+			if tt.expectedErr == nil {
+				require.NoError(t, err)
+				assert.Equal(t, "Alice", res.Name)
+				return
+			}
+			assert.Nil(t, res)
+			var ue *UnmarshalError
+			assert.ErrorAs(t, err, &ue)
+			assert.ErrorIs(t, err, UnmarshalFailedError)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestWithStrictJSON_GetServerInfo(t *testing.T) {
+	tests := []struct {
+		name        string
+		options     []Option
+		expectedErr error
+	}{
+		{name: "default", expectedErr: UnmarshalFailedError},
+		{name: "strict", options: []Option{WithStrictJSON()}, expectedErr: UnmarshalTrailingDataError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newMockDoer(t, &contracts.Response{
+				StatusCode: http.StatusOK,
+				Body:       []byte(`{"name":"server"}{"name":"server"}`),
+			}, nil, nil)
+			client := MustNewClient("http://localhost:8081/api/", "", append(tt.options, WithClient(mockDoer))...)
+
+			// Act
+			info, err := client.GetServerInfo(context.Background())
+
+			// Assert
+			assert.Nil(t, info)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestUnmarshalErrorStats(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		if requestPath(req) == "/server" {
+			return &contracts.Response{StatusCode: http.StatusOK, Body: []byte(`{"name":42}`)}, nil
+		}
+		return &contracts.Response{StatusCode: http.StatusOK, Body: []byte(`{"id":`)}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+	ctx := context.Background()
+
+	// Act
+	statsBefore := client.UnmarshalErrorStats()
+	_, keyErr := client.GetAccessKey(ctx, "1")
+	_, _ = client.GetAccessKey(ctx, "1")
+	_, _ = client.GetServerInfo(ctx)
+	stats := client.UnmarshalErrorStats()
+
+	// Assert
+	assert.Empty(t, statsBefore)
+	var ue *UnmarshalError
+	require.ErrorAs(t, keyErr, &ue)
+	assert.Equal(t, "*types.AccessKey", ue.Type())
+	assert.Equal(t, map[string]int64{
+		"*types.AccessKey":          2,
+		"*types.ServerInfoResponse": 1,
+	}, stats)
+}
+
+func TestUnmarshalWithErrorInternal_Stats(t *testing.T) {
+	// Arrange
+	var stats unmarshalStats
+	var person testPerson
+
+	// Act
+	_ = unmarshalWithErrorInternal([]byte(`{"name":"Alice"}`), &person, "testPerson", withUnmarshalStats(&stats))
+	_ = unmarshalWithErrorInternal([]byte{}, &person, "testPerson", withUnmarshalStats(&stats))
+	_ = unmarshalWithErrorInternal([]byte(`{"name":1}`), &person, "testPerson", withUnmarshalStats(&stats))
+
+	// Assert
+	assert.Equal(t, map[string]int64{"testPerson": 2}, stats.snapshot())
+}
@@ -0,0 +1,164 @@
+package outline
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+)
+
+// === Usage of Access Keys ===
+
+// GetKeysByUsage returns all access keys paired with their transferred bytes,
+// ordered by usage in ascending or descending order.
+// Keys without recorded usage count as zero. Ties are ordered by ascending key ID,
+// comparing numerically when both IDs are integers.
+//
+// It returns the errors of [Client.GetAccessKeys] and [Client.GetMetricsTransfer].
+func (c *Client) GetKeysByUsage(ctx context.Context, descending bool) ([]types.AccessKeyUsage, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	keys, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := c.GetMetricsTransfer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := make([]types.AccessKeyUsage, 0, len(keys))
+	for _, key := range keys {
+		usage = append(usage, types.AccessKeyUsage{
+			Key:              key,
+			BytesTransferred: transfer.BytesTransferredByUserID[key.ID],
+		})
+	}
+
+	slices.SortFunc(usage, func(a, b types.AccessKeyUsage) int {
+		byBytes := cmp.Compare(a.BytesTransferred, b.BytesTransferred)
+		if descending {
+			byBytes = -byBytes
+		}
+		if byBytes != 0 {
+			return byBytes
+		}
+		return compareAccessKeyIDs(a.Key.ID, b.Key.ID)
+	})
+
+	return usage, nil
+}
+
+// GetAccessKeyRemainingBytes returns how many bytes the access key may still transfer:
+// its data limit minus the bytes it has transferred, clamped at zero.
+// A key without its own limit is bound by the server-wide limit of [Client.GetServerInfo], if any;
+// if neither is set, unlimited is true and remaining is zero.
+//
+// It returns [*ClientError] wrapping [AccessKeyNotFoundError] if the key does not exist,
+// and the errors of [Client.GetServerInfo] and [Client.GetMetricsTransfer].
+func (c *Client) GetAccessKeyRemainingBytes(ctx context.Context, keyID string) (remaining int64, unlimited bool, err error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	key, err := c.getAccessKey(ctx, keyID, false)
+	if err != nil {
+		return 0, false, err
+	}
+
+	limit := key.Limit
+	if limit == nil {
+		info, err := c.GetServerInfo(ctx)
+		if err != nil {
+			return 0, false, err
+		}
+		limit = info.AccessKeyDataLimit
+	}
+	if limit == nil {
+		return 0, true, nil
+	}
+
+	transfer, err := c.GetMetricsTransfer(ctx)
+	if err != nil {
+		return 0, false, err
+	}
+
+	used := max(transfer.BytesTransferredByUserID[key.ID], 0)
+	if uint64(used) >= limit.Bytes {
+		return 0, false, nil
+	}
+	return int64(min(limit.Bytes-uint64(used), math.MaxInt64)), false, nil
+}
+
+// ServerUtilization reports how much of the provisioned data limits the access keys have used:
+// the bytes transferred by the limited keys as a percentage of the sum of their limits,
+// together with the counts of keys that used up their limit and of keys without a limit.
+// A key without its own limit is bound by the server-wide limit of [Client.GetServerInfo], if any.
+// If no limit is provisioned, the utilization is reported as unlimited with a zero percentage.
+//
+// It returns the errors of [Client.GetAccessKeys], [Client.GetServerInfo] and [Client.GetMetricsTransfer].
+func (c *Client) ServerUtilization(ctx context.Context) (*types.Utilization, error) {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	keys, err := c.GetAccessKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var serverLimit *types.Limit
+	if slices.ContainsFunc(keys, func(key *types.AccessKey) bool { return key.Limit == nil }) {
+		info, err := c.GetServerInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		serverLimit = info.AccessKeyDataLimit
+	}
+
+	transfer, err := c.GetMetricsTransfer(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	utilization := &types.Utilization{}
+	for _, used := range transfer.BytesTransferredByUserID {
+		utilization.TransferredBytes += max(used, 0)
+	}
+
+	var limitedUsed uint64
+	for _, key := range keys {
+		limit := cmp.Or(key.Limit, serverLimit)
+		if limit == nil {
+			utilization.UnlimitedKeys++
+			continue
+		}
+		used := uint64(max(transfer.BytesTransferredByUserID[key.ID], 0))
+		if used >= limit.Bytes {
+			utilization.OverLimitKeys++
+		}
+		limitedUsed += used
+		utilization.LimitBytes += limit.Bytes
+	}
+
+	if utilization.LimitBytes == 0 {
+		utilization.Unlimited = true
+		return utilization, nil
+	}
+	utilization.UsedPercent = float64(limitedUsed) / float64(utilization.LimitBytes) * 100
+	return utilization, nil
+}
+
+// compareAccessKeyIDs orders access key IDs numerically when both are integers
+// and lexically otherwise.
+func compareAccessKeyIDs(a, b string) int {
+	an, errA := strconv.ParseInt(a, 10, 64)
+	bn, errB := strconv.ParseInt(b, 10, 64)
+	if errA == nil && errB == nil {
+		return cmp.Compare(an, bn)
+	}
+	return cmp.Compare(a, b)
+}
@@ -0,0 +1,264 @@
+package outline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// usageTestHandler serves the given keys and transfer metrics.
+func usageTestHandler(keys []*types.AccessKey, transfer map[string]int64) func(req *contracts.Request) (*contracts.Response, error) {
+	return func(req *contracts.Request) (*contracts.Response, error) {
+		switch requestPath(req) {
+		case "/access-keys":
+			return jsonResponse(http.StatusOK, map[string]any{"accessKeys": keys}), nil
+		case "/metrics/transfer":
+			return jsonResponse(http.StatusOK, types.MetricsTransfer{BytesTransferredByUserID: transfer}), nil
+		}
+		return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+	}
+}
+
+// === GetKeysByUsage Tests ===
+
+func TestGetKeysByUsage(t *testing.T) {
+	keys := []*types.AccessKey{{ID: "10"}, {ID: "2"}, {ID: "3"}, {ID: "1"}, {ID: "4"}}
+	transfer := map[string]int64{"10": 500, "2": 100, "3": 500, "99": 1000}
+
+	tests := []struct {
+		name        string
+		descending  bool
+		expectedIDs []string
+		expectedUse []int64
+	}{
+		{
+			name:        "ascending",
+			descending:  false,
+			expectedIDs: []string{"1", "4", "2", "3", "10"},
+			expectedUse: []int64{0, 0, 100, 500, 500},
+		},
+		{
+			name:        "descending",
+			descending:  true,
+			expectedIDs: []string{"3", "10", "2", "1", "4"},
+			expectedUse: []int64{500, 500, 100, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newRoutingMockDoer(t, nil, usageTestHandler(keys, transfer))
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			result, err := client.GetKeysByUsage(context.Background(), tt.descending)
+
+			// Assert
+			require.NoError(t, err)
+			ids := make([]string, len(result))
+			used := make([]int64, len(result))
+			for i, u := range result {
+				ids[i] = u.Key.ID
+				used[i] = u.BytesTransferred
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+			assert.Equal(t, tt.expectedUse, used)
+		})
+	}
+}
+
+func TestGetKeysByUsage_TransferError(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		if requestPath(req) == "/access-keys" {
+			return jsonResponse(http.StatusOK, map[string]any{"accessKeys": []*types.AccessKey{{ID: "1"}}}), nil
+		}
+		return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	result, err := client.GetKeysByUsage(context.Background(), false)
+
+	// Assert
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+}
+
+func TestCompareAccessKeyIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected int
+	}{
+		{name: "numeric", a: "2", b: "10", expected: -1},
+		{name: "equal", a: "7", b: "7", expected: 0},
+		{name: "lexical", a: "b", b: "a", expected: 1},
+		{name: "mixed", a: "10", b: "a", expected: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, compareAccessKeyIDs(tt.a, tt.b))
+		})
+	}
+}
+
+// === GetAccessKeyRemainingBytes Tests ===
+
+func TestGetAccessKeyRemainingBytes(t *testing.T) {
+	tests := []struct {
+		name              string
+		keyLimit          *types.Limit
+		serverLimit       *types.Limit
+		used              int64
+		expectedRemaining int64
+		expectedUnlimited bool
+	}{
+		{name: "under limit", keyLimit: &types.Limit{Bytes: 1000}, used: 400, expectedRemaining: 600},
+		{name: "over limit", keyLimit: &types.Limit{Bytes: 1000}, used: 1500, expectedRemaining: 0},
+		{name: "no usage", keyLimit: &types.Limit{Bytes: 1000}, expectedRemaining: 1000},
+		{name: "server-wide limit", serverLimit: &types.Limit{Bytes: 500}, used: 100, expectedRemaining: 400},
+		{name: "unlimited", used: 100, expectedUnlimited: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+				switch requestPath(req) {
+				case "/access-keys/1":
+					return jsonResponse(http.StatusOK, types.AccessKey{ID: "1", Limit: tt.keyLimit}), nil
+				case "/server":
+					return jsonResponse(http.StatusOK, types.ServerInfoResponse{AccessKeyDataLimit: tt.serverLimit}), nil
+				case "/metrics/transfer":
+					return jsonResponse(http.StatusOK, types.MetricsTransfer{
+						BytesTransferredByUserID: map[string]int64{"1": tt.used, "2": 99999},
+					}), nil
+				}
+				return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			remaining, unlimited, err := client.GetAccessKeyRemainingBytes(context.Background(), "1")
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRemaining, remaining)
+			assert.Equal(t, tt.expectedUnlimited, unlimited)
+		})
+	}
+}
+
+func TestGetAccessKeyRemainingBytes_NotFound(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		return &contracts.Response{StatusCode: http.StatusNotFound}, nil
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer), WithNotFoundAsNil())
+
+	// Act
+	remaining, unlimited, err := client.GetAccessKeyRemainingBytes(context.Background(), "missing")
+
+	// Assert
+	assert.ErrorIs(t, err, AccessKeyNotFoundError)
+	assert.Zero(t, remaining)
+	assert.False(t, unlimited)
+}
+
+// === ServerUtilization Tests ===
+
+func TestServerUtilization(t *testing.T) {
+	tests := []struct {
+		name        string
+		keys        []*types.AccessKey
+		serverLimit *types.Limit
+		transfer    map[string]int64
+		expected    types.Utilization
+	}{
+		{
+			name: "per-key limits",
+			keys: []*types.AccessKey{
+				{ID: "1", Limit: &types.Limit{Bytes: 1000}},
+				{ID: "2", Limit: &types.Limit{Bytes: 3000}},
+			},
+			transfer: map[string]int64{"1": 500, "2": 500},
+			expected: types.Utilization{TransferredBytes: 1000, LimitBytes: 4000, UsedPercent: 25},
+		},
+		{
+			name: "over-limit and unlimited keys",
+			keys: []*types.AccessKey{
+				{ID: "1", Limit: &types.Limit{Bytes: 1000}},
+				{ID: "2", Limit: &types.Limit{Bytes: 1000}},
+				{ID: "3"},
+			},
+			transfer: map[string]int64{"1": 1500, "2": 1000, "3": 7000},
+			expected: types.Utilization{
+				TransferredBytes: 9500, LimitBytes: 2000, UsedPercent: 125, OverLimitKeys: 2, UnlimitedKeys: 1,
+			},
+		},
+		{
+			name:        "server-wide limit",
+			keys:        []*types.AccessKey{{ID: "1"}, {ID: "2", Limit: &types.Limit{Bytes: 2000}}},
+			serverLimit: &types.Limit{Bytes: 2000},
+			transfer:    map[string]int64{"1": 1000},
+			expected:    types.Utilization{TransferredBytes: 1000, LimitBytes: 4000, UsedPercent: 25},
+		},
+		{
+			name:     "no limits",
+			keys:     []*types.AccessKey{{ID: "1"}, {ID: "2"}},
+			transfer: map[string]int64{"1": 100, "deleted": 50},
+			expected: types.Utilization{TransferredBytes: 150, Unlimited: true, UnlimitedKeys: 2},
+		},
+		{
+			name:     "no keys",
+			expected: types.Utilization{Unlimited: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			keysHandler := usageTestHandler(tt.keys, tt.transfer)
+			mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+				if requestPath(req) == "/server" {
+					return jsonResponse(http.StatusOK, types.ServerInfoResponse{AccessKeyDataLimit: tt.serverLimit}), nil
+				}
+				return keysHandler(req)
+			})
+			client := createTestClientForAccessKeys(mockDoer)
+
+			// Act
+			utilization, err := client.ServerUtilization(context.Background())
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, *utilization)
+		})
+	}
+}
+
+func TestServerUtilization_TransferError(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(req *contracts.Request) (*contracts.Response, error) {
+		if requestPath(req) == "/access-keys" {
+			return jsonResponse(http.StatusOK, map[string]any{"accessKeys": []*types.AccessKey{}}), nil
+		}
+		return &contracts.Response{StatusCode: http.StatusInternalServerError}, nil
+	})
+	client := createTestClientForAccessKeys(mockDoer)
+
+	// Act
+	utilization, err := client.ServerUtilization(context.Background())
+
+	// Assert
+	assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+	assert.Nil(t, utilization)
+}
@@ -0,0 +1,77 @@
+package outline
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+	"time"
+)
+
+// WaitReady polls the server information endpoint until the server answers successfully
+// or ctx is done. The first retry waits poll; the delay doubles after every failed attempt,
+// up to 30 seconds or poll, whichever is larger. The server information cache is not used.
+//
+// Only transient failures are retried: network failures of the request ([*DoError]), such as
+// timeouts and refused connections, and [ServiceUnavailableError] responses. Any other error,
+// such as the 404 Outline returns for a wrong secret, is returned immediately.
+//
+// It returns [*ArgumentError] if poll is not positive, the first non-transient error,
+// or, once ctx is done, [*NotReadyError] wrapping [ServerNotReadyError], the context error
+// and the last failure.
+func (c *Client) WaitReady(ctx context.Context, poll time.Duration) error {
+	ctx, cancel := c.withOperationTimeout(ctx)
+	defer cancel()
+
+	if poll <= 0 {
+		return errInvalidArgument("poll", errors.New("poll interval must be positive"))
+	}
+
+	backoff := retryConfig{baseDelay: poll}
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		_, err := c.fetchServerInfo(ctx)
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			// Prefer the failure seen while ctx was alive over the cancelled attempt.
+			return errServerNotReady(attempt, ctx.Err(), cmp.Or(lastErr, err))
+		}
+		if !isTransientError(err) {
+			return err
+		}
+		lastErr = err
+
+		delay := max(backoff.backoff(attempt), poll)
+		c.logger.Debugf(ctx, "WaitReady: server not ready: attempt=%d delay=%s: %v", attempt, delay, err)
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return errServerNotReady(attempt, sleepErr, err)
+		}
+	}
+}
+
+// isTransientError reports whether err is a failure that may succeed when repeated:
+// a server temporarily unavailable, or a request that failed on the network because it timed out,
+// the connection was refused or reset, or the server closed it early. Other request failures,
+// such as a certificate pin mismatch, a proxy configuration error or an oversized response, are not transient.
+func isTransientError(err error) bool {
+	if errors.Is(err, ServiceUnavailableError) {
+		return true
+	}
+	var doErr *DoError
+	if !errors.As(err, &doErr) || errors.Is(err, ResponseTooLargeError) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNABORTED) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,171 @@
+package outline
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/nepriyatelev/outline-client-go/internal/contracts"
+	"github.com/nepriyatelev/outline-client-go/outline/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// === WaitReady Tests ===
+
+// errConnRefused is the error of a dial to a port nobody listens on.
+var errConnRefused = &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+
+func TestWaitReady_RetriesTransientFailures(t *testing.T) {
+	// Arrange
+	var calls []string
+	attempt := 0
+	mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+		attempt++
+		switch {
+		case attempt <= 2:
+			return nil, errConnRefused
+		case attempt == 3:
+			return &contracts.Response{StatusCode: http.StatusBadGateway}, nil
+		default:
+			return jsonResponse(http.StatusOK, types.ServerInfoResponse{Name: "ready"}), nil
+		}
+	})
+	client := MustNewClient("http://localhost:8081/api/", "",
+		WithClient(mockDoer),
+		WithInitialServerInfo(&types.ServerInfoResponse{Name: "seeded"}),
+	)
+
+	// Act
+	err := client.WaitReady(context.Background(), time.Millisecond)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, calls, 4)
+}
+
+func TestWaitReady_AuthErrorAborts(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+	}{
+		{name: "unauthorized", status: http.StatusUnauthorized},
+		{name: "forbidden", status: http.StatusForbidden},
+		{name: "wrong secret", status: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+				return &contracts.Response{StatusCode: tt.status}, nil
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer))
+
+			// Act
+			err := client.WaitReady(context.Background(), time.Millisecond)
+
+			// Assert
+			var clientErr *ClientError
+			require.ErrorAs(t, err, &clientErr)
+			assert.Equal(t, tt.status, clientErr.StatusCode())
+			assert.Len(t, calls, 1)
+		})
+	}
+}
+
+func TestWaitReady_PermanentRequestErrorAborts(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{name: "nil response"},
+		{name: "certificate pin mismatch", err: errors.New("certificate fingerprint does not match the pinned SHA-256")},
+		{name: "proxy configuration", err: errors.New("proxy socks5://proxy:1080: unknown scheme")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var calls []string
+			mockDoer := newRoutingMockDoer(t, &calls, func(*contracts.Request) (*contracts.Response, error) {
+				return nil, tt.err
+			})
+			client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer))
+
+			// Act
+			err := client.WaitReady(context.Background(), time.Millisecond)
+
+			// Assert
+			var doErr *DoError
+			require.ErrorAs(t, err, &doErr)
+			assert.NotErrorIs(t, err, ServerNotReadyError)
+			assert.Len(t, calls, 1)
+		})
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "connection refused", err: errDoGetServerInfo(errConnRefused), expected: true},
+		{name: "connection reset", err: errDoGetServerInfo(syscall.ECONNRESET), expected: true},
+		{name: "request timeout", err: errDoGetServerInfo(context.DeadlineExceeded), expected: true},
+		{name: "closed before response", err: errDoGetServerInfo(io.ErrUnexpectedEOF), expected: true},
+		{name: "service unavailable", err: errServiceUnavailable(opGetServerInfo, http.StatusServiceUnavailable, nil), expected: true},
+		{name: "nil response", err: errDoGetServerInfo(NilResponseError), expected: false},
+		{name: "oversized response", err: errDoGetServerInfo(errResponseTooLarge(http.StatusOK, 2, 1)), expected: false},
+		{name: "other request error", err: errDoGetServerInfo(errors.New("tls: bad certificate")), expected: false},
+		{name: "not found", err: errStatusCode(opGetServerInfo, http.StatusNotFound, nil), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isTransientError(tt.err))
+		})
+	}
+}
+
+func TestWaitReady_ContextExpires(t *testing.T) {
+	// Arrange
+	mockDoer := newRoutingMockDoer(t, nil, func(*contracts.Request) (*contracts.Response, error) {
+		return nil, errConnRefused
+	})
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(mockDoer))
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// Act
+	err := client.WaitReady(ctx, time.Millisecond)
+
+	// Assert
+	var notReadyErr *NotReadyError
+	require.ErrorAs(t, err, &notReadyErr)
+	assert.Positive(t, notReadyErr.Attempts())
+	assert.ErrorIs(t, err, ClientOutlineError)
+	assert.ErrorIs(t, err, ServerNotReadyError)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorIs(t, err, errConnRefused)
+	assert.Contains(t, err.Error(), "reason: "+errConnRefused.Error())
+}
+
+func TestWaitReady_InvalidPoll(t *testing.T) {
+	// Arrange
+	client := MustNewClient("http://localhost:8081/api/", "", WithClient(NewMockDoer(t)))
+
+	// Act
+	err := client.WaitReady(context.Background(), 0)
+
+	// Assert
+	assert.ErrorIs(t, err, InvalidArgumentError)
+}